@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// Distribution holds the parts of the GetDistribution response used by NewCloudFrontCheck.
+type Distribution struct {
+	Status     string
+	DomainName string
+}
+
+// CloudFrontClient is the part of the CloudFront API used by NewCloudFrontCheck.
+type CloudFrontClient interface {
+	GetDistribution(ctx context.Context, distributionID string) (Distribution, error)
+}
+
+// NewCloudFrontCheck creates a check which verifies the distribution status is Deployed and its domain name
+// answers a HEAD request without a server error.
+func NewCloudFrontCheck(name string, client CloudFrontClient, distributionID string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			d, err := client.GetDistribution(ctx, distributionID)
+			if err != nil {
+				return fmt.Errorf("could not get distribution %q: %w", distributionID, err)
+			}
+
+			if d.Status != "Deployed" {
+				return fmt.Errorf("distribution %q is %s", distributionID, d.Status)
+			}
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodHead, "https://"+d.DomainName, nil)
+			if err != nil {
+				return fmt.Errorf("could not create request: %w", err)
+			}
+
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return fmt.Errorf("domain %s is not reachable: %w", d.DomainName, err)
+			}
+			res.Body.Close()
+
+			if res.StatusCode >= http.StatusInternalServerError {
+				return fmt.Errorf("domain %s answered with status code %d", d.DomainName, res.StatusCode)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,61 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type fakeCloudFront struct {
+	distribution Distribution
+	err          error
+}
+
+func (f fakeCloudFront) GetDistribution(context.Context, string) (Distribution, error) {
+	return f.distribution, f.err
+}
+
+func TestNewCloudFrontCheck(t *testing.T) {
+	tests := map[string]struct {
+		status     string
+		statusCode int
+		err        error
+		wantErr    bool
+	}{
+		"deployed":       {status: "Deployed", statusCode: http.StatusOK},
+		"client error":   {status: "Deployed", statusCode: http.StatusForbidden},
+		"in progress":    {status: "InProgress", statusCode: http.StatusOK, wantErr: true},
+		"origin failing": {status: "Deployed", statusCode: http.StatusBadGateway, wantErr: true},
+		"not found":      {err: errors.New("NoSuchDistribution"), wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodHead {
+					t.Errorf("unexpected method %s", r.Method)
+				}
+				w.WriteHeader(tt.statusCode)
+			}))
+			t.Cleanup(srv.Close)
+
+			// trust the certificate of the test server
+			transport := http.DefaultClient.Transport
+			http.DefaultClient.Transport = srv.Client().Transport
+			t.Cleanup(func() { http.DefaultClient.Transport = transport })
+
+			client := fakeCloudFront{
+				distribution: Distribution{Status: tt.status, DomainName: strings.TrimPrefix(srv.URL, "https://")},
+				err:          tt.err,
+			}
+
+			err := NewCloudFrontCheck("cloudfront", client, "E123").Check(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// TableDescription holds the parts of the DescribeTable response used by NewDynamoDBCheck.
+type TableDescription struct {
+	// TableStatus is the status of the table.
+	TableStatus string
+	// GlobalSecondaryIndexes maps the name of each global secondary index to its IndexStatus.
+	GlobalSecondaryIndexes map[string]string
+}
+
+// DynamoDBClient is the part of the DynamoDB API used by NewDynamoDBCheck.
+type DynamoDBClient interface {
+	DescribeTable(ctx context.Context, tableName string) (TableDescription, error)
+}
+
+// NewDynamoDBCheck creates a check which verifies the table status is ACTIVE, as well as the status of the
+// given global secondary indexes.
+func NewDynamoDBCheck(name string, client DynamoDBClient, tableName string, indexNames ...string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			t, err := client.DescribeTable(ctx, tableName)
+			if err != nil {
+				return fmt.Errorf("could not describe table %q: %w", tableName, err)
+			}
+
+			if t.TableStatus != "ACTIVE" {
+				return fmt.Errorf("table %q is %s", tableName, t.TableStatus)
+			}
+
+			for _, index := range indexNames {
+				status, ok := t.GlobalSecondaryIndexes[index]
+				if !ok {
+					return fmt.Errorf("table %q has no global secondary index %q", tableName, index)
+				}
+
+				if status != "ACTIVE" {
+					return fmt.Errorf("global secondary index %q of table %q is %s", index, tableName, status)
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type fakeDynamoDB struct {
+	table TableDescription
+	err   error
+}
+
+func (f fakeDynamoDB) DescribeTable(context.Context, string) (TableDescription, error) {
+	return f.table, f.err
+}
+
+func TestNewDynamoDBCheck(t *testing.T) {
+	tests := map[string]struct {
+		client  fakeDynamoDB
+		wantErr bool
+	}{
+		"active": {
+			client: fakeDynamoDB{table: TableDescription{TableStatus: "ACTIVE", GlobalSecondaryIndexes: map[string]string{"by-user": "ACTIVE"}}},
+		},
+		"table updating": {
+			client:  fakeDynamoDB{table: TableDescription{TableStatus: "UPDATING", GlobalSecondaryIndexes: map[string]string{"by-user": "ACTIVE"}}},
+			wantErr: true,
+		},
+		"index creating": {
+			client:  fakeDynamoDB{table: TableDescription{TableStatus: "ACTIVE", GlobalSecondaryIndexes: map[string]string{"by-user": "CREATING"}}},
+			wantErr: true,
+		},
+		"missing index": {
+			client:  fakeDynamoDB{table: TableDescription{TableStatus: "ACTIVE"}},
+			wantErr: true,
+		},
+		"table not found": {
+			client:  fakeDynamoDB{err: errors.New("ResourceNotFoundException")},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := NewDynamoDBCheck("dynamodb", tt.client, "orders", "by-user").Check(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// ECSService holds the parts of the DescribeServices response used by NewECSServiceCheck.
+type ECSService struct {
+	DesiredCount int
+	RunningCount int
+}
+
+// ECSClient is the part of the ECS API used by NewECSServiceCheck.
+type ECSClient interface {
+	DescribeServices(ctx context.Context, cluster, service string) (ECSService, error)
+}
+
+// NewECSServiceCheck creates a check which verifies the service runs at least minRunning tasks.
+func NewECSServiceCheck(name string, client ECSClient, cluster, service string, minRunning int) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			s, err := client.DescribeServices(ctx, cluster, service)
+			if err != nil {
+				return fmt.Errorf("could not describe service %q in cluster %q: %w", service, cluster, err)
+			}
+
+			if s.RunningCount < minRunning {
+				return fmt.Errorf("service %q runs %d of %d desired tasks, expected at least %d", service, s.RunningCount, s.DesiredCount, minRunning)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type fakeECS struct {
+	service ECSService
+	err     error
+}
+
+func (f fakeECS) DescribeServices(context.Context, string, string) (ECSService, error) {
+	return f.service, f.err
+}
+
+func TestNewECSServiceCheck(t *testing.T) {
+	tests := map[string]struct {
+		client  fakeECS
+		wantErr bool
+	}{
+		"all tasks running": {client: fakeECS{service: ECSService{DesiredCount: 3, RunningCount: 3}}},
+		"enough tasks":      {client: fakeECS{service: ECSService{DesiredCount: 3, RunningCount: 2}}},
+		"too few tasks":     {client: fakeECS{service: ECSService{DesiredCount: 3, RunningCount: 1}}, wantErr: true},
+		"service not found": {client: fakeECS{err: errors.New("ServiceNotFoundException")}, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := NewECSServiceCheck("ecs", tt.client, "prod", "api", 2).Check(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// ElastiCacheClient is the part of the ElastiCache API used by NewElastiCacheCheck.
+type ElastiCacheClient interface {
+	// DescribeCacheClusters returns the CacheClusterStatus of the cluster, as returned by the DescribeCacheClusters API.
+	DescribeCacheClusters(ctx context.Context, clusterID string) (string, error)
+}
+
+// NewElastiCacheCheck creates a check which verifies the cache cluster status is available.
+func NewElastiCacheCheck(name string, client ElastiCacheClient, clusterId string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			status, err := client.DescribeCacheClusters(ctx, clusterId)
+			if err != nil {
+				return fmt.Errorf("could not describe cache cluster %q: %w", clusterId, err)
+			}
+
+			if status != "available" {
+				return fmt.Errorf("cache cluster %q is %s", clusterId, status)
+			}
+
+			return nil
+		},
+	}
+}
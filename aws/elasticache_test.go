@@ -0,0 +1,37 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type fakeElastiCache struct {
+	status string
+	err    error
+}
+
+func (f fakeElastiCache) DescribeCacheClusters(context.Context, string) (string, error) {
+	return f.status, f.err
+}
+
+func TestNewElastiCacheCheck(t *testing.T) {
+	tests := map[string]struct {
+		client  fakeElastiCache
+		wantErr bool
+	}{
+		"available":         {client: fakeElastiCache{status: "available"}},
+		"modifying":         {client: fakeElastiCache{status: "modifying"}, wantErr: true},
+		"snapshotting":      {client: fakeElastiCache{status: "snapshotting"}, wantErr: true},
+		"cluster not found": {client: fakeElastiCache{err: errors.New("CacheClusterNotFound")}, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := NewElastiCacheCheck("elasticache", tt.client, "sessions").Check(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
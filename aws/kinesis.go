@@ -0,0 +1,33 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// KinesisClient is the part of the Kinesis API used by NewKinesisCheck.
+type KinesisClient interface {
+	// DescribeStreamSummary returns the StreamStatus of the stream, as returned by the DescribeStreamSummary API.
+	DescribeStreamSummary(ctx context.Context, streamName string) (string, error)
+}
+
+// NewKinesisCheck creates a check which verifies the stream status is ACTIVE.
+func NewKinesisCheck(name string, client KinesisClient, streamName string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			status, err := client.DescribeStreamSummary(ctx, streamName)
+			if err != nil {
+				return fmt.Errorf("could not describe stream %q: %w", streamName, err)
+			}
+
+			if status != "ACTIVE" {
+				return fmt.Errorf("stream %q is %s", streamName, status)
+			}
+
+			return nil
+		},
+	}
+}
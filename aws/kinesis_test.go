@@ -0,0 +1,37 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type fakeKinesis struct {
+	status string
+	err    error
+}
+
+func (f fakeKinesis) DescribeStreamSummary(context.Context, string) (string, error) {
+	return f.status, f.err
+}
+
+func TestNewKinesisCheck(t *testing.T) {
+	tests := map[string]struct {
+		client  fakeKinesis
+		wantErr bool
+	}{
+		"active":           {client: fakeKinesis{status: "ACTIVE"}},
+		"updating":         {client: fakeKinesis{status: "UPDATING"}, wantErr: true},
+		"deleting":         {client: fakeKinesis{status: "DELETING"}, wantErr: true},
+		"stream not found": {client: fakeKinesis{err: errors.New("ResourceNotFoundException")}, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := NewKinesisCheck("kinesis", tt.client, "events").Check(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
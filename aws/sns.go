@@ -0,0 +1,43 @@
+// Package aws provides health checks for AWS services.
+//
+// The checks depend on small client interfaces instead of the AWS SDK, so this module does not pull it in.
+// Each interface is implemented by a thin adapter around the matching SDK client.
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// SNSClient is the part of the SNS API used by NewSNSCheck.
+type SNSClient interface {
+	// GetTopicAttributes returns the attributes of the topic, as returned by the GetTopicAttributes API.
+	GetTopicAttributes(ctx context.Context, topicARN string) (map[string]string, error)
+}
+
+// NewSNSCheck creates a check which verifies the topic exists and has at least minSubscriptions confirmed subscriptions.
+func NewSNSCheck(name string, client SNSClient, topicARN string, minSubscriptions int) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			attrs, err := client.GetTopicAttributes(ctx, topicARN)
+			if err != nil {
+				return fmt.Errorf("could not get attributes of topic %q: %w", topicARN, err)
+			}
+
+			confirmed, err := strconv.Atoi(attrs["SubscriptionsConfirmed"])
+			if err != nil {
+				return fmt.Errorf("invalid SubscriptionsConfirmed attribute %q", attrs["SubscriptionsConfirmed"])
+			}
+
+			if confirmed < minSubscriptions {
+				return fmt.Errorf("topic %q has %d confirmed subscriptions, expected at least %d", topicARN, confirmed, minSubscriptions)
+			}
+
+			return nil
+		},
+	}
+}
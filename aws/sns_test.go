@@ -0,0 +1,37 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type fakeSNS struct {
+	attrs map[string]string
+	err   error
+}
+
+func (f fakeSNS) GetTopicAttributes(context.Context, string) (map[string]string, error) {
+	return f.attrs, f.err
+}
+
+func TestNewSNSCheck(t *testing.T) {
+	tests := map[string]struct {
+		client  fakeSNS
+		wantErr bool
+	}{
+		"enough subscriptions":  {client: fakeSNS{attrs: map[string]string{"SubscriptionsConfirmed": "3"}}},
+		"too few subscriptions": {client: fakeSNS{attrs: map[string]string{"SubscriptionsConfirmed": "1"}}, wantErr: true},
+		"missing attribute":     {client: fakeSNS{attrs: map[string]string{}}, wantErr: true},
+		"topic not found":       {client: fakeSNS{err: errors.New("NotFound")}, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := NewSNSCheck("sns", tt.client, "arn:aws:sns:us-east-1:1:orders", 2).Check(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+)
+
+var managementAPI = "https://management.azure.com"
+
+// armGet calls the Azure Resource Manager API at path, authenticated with cred, and decodes the response into v.
+func armGet(ctx context.Context, cred azcore.TokenCredential, path string, v any) error {
+	token, err := cred.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{managementAPI + "/.default"}})
+	if err != nil {
+		return fmt.Errorf("could not get token: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, managementAPI+path, nil)
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token.Token)
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return fmt.Errorf("could not decode response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package azure
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+)
+
+var errNoToken = errors.New("no token")
+
+// fakeCredential returns a fixed token, or err if set.
+type fakeCredential struct {
+	err error
+}
+
+func (c fakeCredential) GetToken(context.Context, policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	if c.err != nil {
+		return azcore.AccessToken{}, c.err
+	}
+
+	return azcore.AccessToken{Token: "token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+// mockManagementAPI points the Azure Management API at a server expecting a request to path and responding
+// with status and body.
+func mockManagementAPI(t *testing.T, path string, status int, body string) {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RequestURI() != path {
+			t.Errorf("unexpected request %q", r.URL.RequestURI())
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("unexpected authorization %q", got)
+		}
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	original := managementAPI
+	managementAPI = srv.URL
+	t.Cleanup(func() { managementAPI = original })
+}
@@ -0,0 +1,16 @@
+module github.com/pcordeiro/go-health/azure
+
+go 1.19
+
+require (
+	github.com/Azure/azure-sdk-for-go/sdk/azcore v1.6.0
+	github.com/pcordeiro/go-health v0.0.0-00010101000000-000000000000
+)
+
+require (
+	github.com/Azure/azure-sdk-for-go/sdk/internal v1.3.0 // indirect
+	golang.org/x/net v0.10.0 // indirect
+	golang.org/x/text v0.13.0 // indirect
+)
+
+replace github.com/pcordeiro/go-health => ../
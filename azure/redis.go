@@ -0,0 +1,38 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// NewAzureRedisCheck creates a check which calls the Azure Management API to verify the provisioning state
+// of the Azure Cache for Redis instance is Succeeded.
+func NewAzureRedisCheck(name, resourceGroup, cacheName, subscriptionID string, cred azcore.TokenCredential) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			path := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cache/redis/%s?api-version=2023-08-01",
+				url.PathEscape(subscriptionID), url.PathEscape(resourceGroup), url.PathEscape(cacheName))
+
+			var cache struct {
+				Properties struct {
+					ProvisioningState string `json:"provisioningState"`
+				} `json:"properties"`
+			}
+			if err := armGet(ctx, cred, path, &cache); err != nil {
+				return err
+			}
+
+			if cache.Properties.ProvisioningState != "Succeeded" {
+				return fmt.Errorf("cache %q provisioning state is %s", cacheName, cache.Properties.ProvisioningState)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package azure
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+)
+
+func TestNewAzureRedisCheck(t *testing.T) {
+	const path = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/redis/sessions?api-version=2023-08-01"
+
+	tests := map[string]struct {
+		status  int
+		body    string
+		cred    azcore.TokenCredential
+		wantErr bool
+	}{
+		"succeeded": {status: http.StatusOK, body: `{"properties":{"provisioningState":"Succeeded"}}`},
+		"scaling":   {status: http.StatusOK, body: `{"properties":{"provisioningState":"Scaling"}}`, wantErr: true},
+		"failed":    {status: http.StatusOK, body: `{"properties":{"provisioningState":"Failed"}}`, wantErr: true},
+		"not found": {status: http.StatusNotFound, body: `{}`, wantErr: true},
+		"no token":  {status: http.StatusOK, body: `{}`, cred: fakeCredential{err: errNoToken}, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockManagementAPI(t, path, tt.status, tt.body)
+
+			cred := tt.cred
+			if cred == nil {
+				cred = fakeCredential{}
+			}
+
+			err := NewAzureRedisCheck("redis", "rg", "sessions", "sub", cred).Check(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
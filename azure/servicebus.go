@@ -0,0 +1,46 @@
+// Package azure provides health checks for Azure services.
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// NewAzureServiceBusNamespaceCheck creates a check which calls the Azure Management API to verify the provisioning
+// state of the Service Bus namespace is Succeeded and the namespace is active. Both are only exposed by the Azure
+// Resource Manager, which authorizes Azure AD tokens and not the SAS keys of a connection string, so the check takes
+// the resource group, subscription and a credential, e.g. from azidentity, rather than a connection string.
+func NewAzureServiceBusNamespaceCheck(name, resourceGroup, namespace, subscriptionID string, cred azcore.TokenCredential) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			path := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s?api-version=2021-11-01",
+				url.PathEscape(subscriptionID), url.PathEscape(resourceGroup), url.PathEscape(namespace))
+
+			var ns struct {
+				Properties struct {
+					ProvisioningState string `json:"provisioningState"`
+					Status            string `json:"status"`
+				} `json:"properties"`
+			}
+			if err := armGet(ctx, cred, path, &ns); err != nil {
+				return err
+			}
+
+			if ns.Properties.ProvisioningState != "Succeeded" {
+				return fmt.Errorf("namespace %q provisioning state is %s", namespace, ns.Properties.ProvisioningState)
+			}
+
+			if ns.Properties.Status != "" && ns.Properties.Status != "Active" {
+				return fmt.Errorf("namespace %q is %s", namespace, ns.Properties.Status)
+			}
+
+			return nil
+		},
+	}
+}
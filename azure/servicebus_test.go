@@ -0,0 +1,42 @@
+package azure
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+)
+
+func TestNewAzureServiceBusNamespaceCheck(t *testing.T) {
+	const path = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.ServiceBus/namespaces/orders?api-version=2021-11-01"
+
+	tests := map[string]struct {
+		status  int
+		body    string
+		cred    azcore.TokenCredential
+		wantErr bool
+	}{
+		"succeeded": {status: http.StatusOK, body: `{"properties":{"provisioningState":"Succeeded","status":"Active"}}`},
+		"creating":  {status: http.StatusOK, body: `{"properties":{"provisioningState":"Creating","status":"Activating"}}`, wantErr: true},
+		"disabled":  {status: http.StatusOK, body: `{"properties":{"provisioningState":"Succeeded","status":"Disabled"}}`, wantErr: true},
+		"not found": {status: http.StatusNotFound, body: `{}`, wantErr: true},
+		"no token":  {status: http.StatusOK, body: `{}`, cred: fakeCredential{err: errNoToken}, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockManagementAPI(t, path, tt.status, tt.body)
+
+			cred := tt.cred
+			if cred == nil {
+				cred = fakeCredential{}
+			}
+
+			err := NewAzureServiceBusNamespaceCheck("servicebus", "rg", "orders", "sub", cred).Check(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package health
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+)
+
+// selector returns the cache key of a run of the checks with the given names,
+// the empty string standing for every check.
+func (h *Health) selector(names []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+
+	normalized := make([]string, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, n := range names {
+		n = h.normalizeName(n)
+		if !seen[n] {
+			seen[n] = true
+			normalized = append(normalized, n)
+		}
+	}
+	sort.Strings(normalized)
+
+	return strings.Join(normalized, "\n")
+}
+
+// selected returns the registered checks with the given names, every check if there are none.
+func (h *Health) selected(names []string) []Check {
+	checks := h.registered()
+	if len(names) == 0 {
+		return checks
+	}
+
+	wanted := make(map[string]bool, len(names))
+	for _, n := range names {
+		wanted[h.normalizeName(n)] = true
+	}
+
+	filtered := checks[:0]
+	for _, c := range checks {
+		if wanted[c.Name] {
+			filtered = append(filtered, c)
+		}
+	}
+
+	return filtered
+}
+
+// cached returns the cached result for the selector if it can be used. With stale-while-revalidate
+// any cached result is used and a background refresh is started once it is stale. h.mu must be held.
+func (h *Health) cached(key string, names []string) (Result, bool) {
+	r, ok := h.cache[key]
+	if !ok {
+		return Result{}, false
+	}
+
+	age := time.Since(r.Timestamp)
+
+	if h.staleAfter > 0 {
+		if age >= h.staleAfter && !h.refreshing[key] {
+			h.refreshing[key] = true
+			go h.refresh(key, append([]string(nil), names...))
+		}
+
+		return r, true
+	}
+
+	return r, age < h.cacheTTL
+}
+
+// refresh performs the checks of the selector in the background and caches their result.
+func (h *Health) refresh(key string, names []string) {
+	result := h.run(context.Background(), h.selected(names))
+
+	h.mu.Lock()
+	result = h.store(key, result, true)
+	delete(h.refreshing, key)
+	h.mu.Unlock()
+
+	h.logRun(result)
+
+	if key == "" {
+		h.publish(result)
+	}
+}
+
+// store records the result of a run for the selector and returns it, caching it if cacheable. A run of every
+// check also becomes the last result, its status smoothed by WithHysteresis. h.mu must be held.
+func (h *Health) store(key string, r Result, cacheable bool) Result {
+	if key == "" {
+		h.history.add(r.Status)
+		r.Status = h.hysteresis.apply(r.Status)
+		h.last = &r
+	}
+
+	if cacheable && (h.cacheTTL > 0 || h.staleAfter > 0) {
+		h.cache[key] = r
+	}
+
+	return r
+}
+
+// InvalidateCache drops every cached result including the named check, so the next run performs it again.
+func (h *Health) InvalidateCache(name string) {
+	name = h.normalizeName(name)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for key := range h.cache {
+		if key == "" || containsName(key, name) {
+			delete(h.cache, key)
+		}
+	}
+}
+
+// InvalidateAll drops every cached result, so the next run performs the checks again.
+func (h *Health) InvalidateAll() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.cache = make(map[string]Result)
+}
+
+// containsName reports whether the selector includes the check name.
+func containsName(key, name string) bool {
+	for _, n := range strings.Split(key, "\n") {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Warmup performs every check, bypassing the cache, and caches the result so that, with WithCacheTTL or
+// WithStaleWhileRevalidate, the first probe is served from it. It is meant to be called at startup.
+func (h *Health) Warmup(ctx context.Context) Result {
+	result := h.run(ctx, h.registered())
+
+	h.mu.Lock()
+	result = h.store("", result, !timeoutScaled(ctx))
+	h.mu.Unlock()
+
+	h.logRun(result)
+
+	h.publish(result)
+
+	return h.output(result)
+}
+
+// output returns a copy of a stored result for the caller, transformed if a transform is set. The copy does not
+// share its maps with the stored result, so neither the transform nor the caller can alter the cache.
+func (h *Health) output(r Result) Result {
+	r.Failures = copyMap(r.Failures)
+	r.FailureCodes = copyMap(r.FailureCodes)
+	r.Checks = copyMap(r.Checks)
+	if r.System != nil {
+		system := *r.System
+		r.System = &system
+	}
+
+	if h.transform != nil {
+		return h.transform(r)
+	}
+
+	return r
+}
+
+// copyMap returns a shallow copy of m, nil if m is nil.
+func copyMap[V any](m map[string]V) map[string]V {
+	if m == nil {
+		return nil
+	}
+
+	c := make(map[string]V, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+
+	return c
+}
@@ -0,0 +1,218 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingCheck returns a check counting its calls in calls.
+func countingCheck(name string, calls *atomic.Int32, err error) Check {
+	return Check{Name: name, Check: func(context.Context) error {
+		calls.Add(1)
+		return err
+	}}
+}
+
+func TestCacheTTL(t *testing.T) {
+	var db, cache atomic.Int32
+	h := newHealth(t,
+		WithCacheTTL(time.Hour),
+		WithChecks(countingCheck("db", &db, nil), countingCheck("cache", &cache, nil)),
+	)
+	ctx := context.Background()
+
+	full := h.Check(ctx)
+	subset := h.CheckFiltered(ctx, "db")
+
+	if len(full.Checks) != 2 || len(subset.Checks) != 1 {
+		t.Fatalf("expected 2 checks in the full run and 1 in the subset, got %d and %d", len(full.Checks), len(subset.Checks))
+	}
+	if db.Load() != 2 || cache.Load() != 1 {
+		t.Fatalf("expected the subset to be cached separately, got %d db and %d cache calls", db.Load(), cache.Load())
+	}
+
+	for i := 0; i < 3; i++ {
+		if r := h.Check(ctx); r.Timestamp != full.Timestamp {
+			t.Error("expected the full run to be served from the cache")
+		}
+		if r := h.CheckFiltered(ctx, "db", "db"); r.Timestamp != subset.Timestamp || len(r.Checks) != 1 {
+			t.Error("expected the subset to be served from its own cache")
+		}
+	}
+	if db.Load() != 2 || cache.Load() != 1 {
+		t.Errorf("expected no check to run within the TTL, got %d db and %d cache calls", db.Load(), cache.Load())
+	}
+
+	if r := h.CheckFiltered(ctx, "cache", "db"); len(r.Checks) != 2 || db.Load() != 3 {
+		t.Errorf("expected a distinct subset to run, got %d checks and %d db calls", len(r.Checks), db.Load())
+	}
+}
+
+func TestCacheTTLExpiry(t *testing.T) {
+	var calls atomic.Int32
+	h := newHealth(t, WithCacheTTL(time.Millisecond), WithChecks(countingCheck("db", &calls, nil)))
+
+	h.Check(context.Background())
+	time.Sleep(5 * time.Millisecond)
+	h.Check(context.Background())
+
+	if calls.Load() != 2 {
+		t.Errorf("expected the checks to run again once the TTL elapsed, got %d calls", calls.Load())
+	}
+}
+
+func TestCachedResultIsolation(t *testing.T) {
+	var calls atomic.Int32
+	h := newHealth(t,
+		WithCacheTTL(time.Hour),
+		WithResultTransform(func(r Result) Result {
+			delete(r.Failures, "db")
+			r.Checks["db"] = CheckResult{Status: "redacted"}
+			return r
+		}),
+		WithChecks(countingCheck("db", &calls, errors.New("dial tcp 10.0.0.1:5432"))),
+	)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			r := h.Check(context.Background())
+			r.FailureCodes["db"] = "mutated by the caller"
+		}()
+	}
+	wg.Wait()
+
+	h.mu.Lock()
+	cached := h.cache[""]
+	h.mu.Unlock()
+
+	if cached.Failures["db"] != "dial tcp 10.0.0.1:5432" || cached.Checks["db"].Status != StatusUnavailable {
+		t.Errorf("expected the cached result not to be altered by the transform, got %+v", cached)
+	}
+	if _, ok := cached.FailureCodes["db"]; ok {
+		t.Error("expected the cached result not to be altered by callers")
+	}
+}
+
+func TestStaleWhileRevalidate(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	h := newHealth(t, WithStaleWhileRevalidate(time.Millisecond), WithChecks(Check{Name: "db", Check: func(context.Context) error {
+		// the first run completes, the refreshes block until released
+		if calls.Add(1) > 1 {
+			<-release
+		}
+		return nil
+	}}))
+	ctx := context.Background()
+
+	first := h.Check(ctx)
+	time.Sleep(5 * time.Millisecond)
+
+	for i := 0; i < 3; i++ {
+		if r := h.Check(ctx); r.Timestamp != first.Timestamp {
+			t.Fatal("expected the stale result to be served while it is refreshed")
+		}
+	}
+
+	close(release)
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		h.mu.Lock()
+		refreshed := !h.cache[""].Timestamp.Equal(first.Timestamp) && !h.refreshing[""]
+		h.mu.Unlock()
+		if refreshed {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected the background refresh to update the cache")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if calls.Load() != 2 {
+		t.Errorf("expected a single background refresh, got %d calls", calls.Load())
+	}
+	if r := h.Check(ctx); r.Timestamp.Equal(first.Timestamp) {
+		t.Error("expected the refreshed result to be served")
+	}
+}
+
+func TestInvalidateCache(t *testing.T) {
+	var db, cache atomic.Int32
+	h := newHealth(t,
+		WithCacheTTL(time.Hour),
+		WithChecks(countingCheck("db", &db, nil), countingCheck("cache", &cache, nil)),
+	)
+	ctx := context.Background()
+
+	h.Check(ctx)
+	h.CheckFiltered(ctx, "db")
+	h.CheckFiltered(ctx, "cache")
+
+	h.InvalidateCache("cache")
+
+	h.Check(ctx)
+	h.CheckFiltered(ctx, "db")
+	h.CheckFiltered(ctx, "cache")
+
+	if db.Load() != 3 {
+		t.Errorf("expected only the full run to be invalidated for db, got %d db calls", db.Load())
+	}
+	if cache.Load() != 4 {
+		t.Errorf("expected every run including cache to be invalidated, got %d cache calls", cache.Load())
+	}
+
+	h.InvalidateAll()
+
+	h.Check(ctx)
+	h.CheckFiltered(ctx, "db")
+
+	if db.Load() != 5 || cache.Load() != 5 {
+		t.Errorf("expected every run to be invalidated, got %d db and %d cache calls", db.Load(), cache.Load())
+	}
+}
+
+func TestWarmup(t *testing.T) {
+	var calls atomic.Int32
+	h := newHealth(t, WithCacheTTL(time.Hour), WithChecks(countingCheck("db", &calls, nil)))
+	ctx := context.Background()
+
+	updates, unsubscribe := h.Subscribe()
+	defer unsubscribe()
+
+	warm := h.Warmup(ctx)
+	if warm.Status != StatusOK || calls.Load() != 1 {
+		t.Fatalf("expected warmup to run the checks, got status %q and %d calls", warm.Status, calls.Load())
+	}
+
+	if r := h.Check(ctx); r.Timestamp != warm.Timestamp || calls.Load() != 1 {
+		t.Errorf("expected the first probe to be served from the warmed cache, got %d calls", calls.Load())
+	}
+	if last, ok := h.LastResult(); !ok || last.Timestamp != warm.Timestamp {
+		t.Error("expected warmup to set the last result")
+	}
+
+	select {
+	case r := <-updates:
+		if r.Timestamp != warm.Timestamp {
+			t.Error("expected subscribers to receive the warmup result")
+		}
+	default:
+		t.Error("expected warmup to publish its result")
+	}
+
+	h.Warmup(ctx)
+	if calls.Load() != 2 {
+		t.Errorf("expected warmup to bypass the cache, got %d calls", calls.Load())
+	}
+}
@@ -0,0 +1,66 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// NewActiveMQCheck creates a check which reads the broker's UptimeMillis attribute through the Jolokia API, failing
+// if the broker reports no uptime. See NewActiveMQCheckWithMaxConnections to also limit the connection count.
+func NewActiveMQCheck(name, jolokiaURL string) health.Check {
+	return NewActiveMQCheckWithMaxConnections(name, jolokiaURL, 0)
+}
+
+// NewActiveMQCheckWithMaxConnections creates a check like NewActiveMQCheck which also fails once the broker's
+// CurrentConnectionsCount reaches maxConnections, e.g. the maximumConnections of its transport connectors, as
+// further clients would be refused. A maxConnections of 0 sets no limit.
+func NewActiveMQCheckWithMaxConnections(name, jolokiaURL string, maxConnections int64) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			u := strings.TrimSuffix(jolokiaURL, "/") + "/read/org.apache.activemq:type=Broker,brokerName=*/UptimeMillis,CurrentConnectionsCount"
+
+			req, err := newRequest(ctx, http.MethodGet, u, nil, map[string]string{"Accept": "application/json"})
+			if err != nil {
+				return err
+			}
+
+			var res struct {
+				Status int    `json:"status"`
+				Error  string `json:"error"`
+				Value  map[string]struct {
+					UptimeMillis            int64 `json:"UptimeMillis"`
+					CurrentConnectionsCount int64 `json:"CurrentConnectionsCount"`
+				} `json:"value"`
+			}
+			if err := getJSON(req, &res); err != nil {
+				return err
+			}
+
+			if res.Status != http.StatusOK {
+				return fmt.Errorf("jolokia returned status %d: %s", res.Status, res.Error)
+			}
+
+			if len(res.Value) == 0 {
+				return errors.New("no ActiveMQ broker found")
+			}
+
+			for broker, attrs := range res.Value {
+				if attrs.UptimeMillis <= 0 {
+					return fmt.Errorf("broker %q reports no uptime", broker)
+				}
+
+				if maxConnections > 0 && attrs.CurrentConnectionsCount >= maxConnections {
+					return fmt.Errorf("broker %q has %d connections, the maximum is %d", broker, attrs.CurrentConnectionsCount, maxConnections)
+				}
+			}
+
+			return nil
+		},
+	}
+}
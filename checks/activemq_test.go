@@ -0,0 +1,57 @@
+package checks
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestNewActiveMQCheck(t *testing.T) {
+	tests := map[string]struct {
+		body           string
+		maxConnections int64
+		wantErr        bool
+	}{
+		"healthy": {
+			body: `{"status":200,"value":{"org.apache.activemq:brokerName=a,type=Broker":{"UptimeMillis":1000,"CurrentConnectionsCount":3}}}`,
+		},
+		"no uptime": {
+			body:    `{"status":200,"value":{"org.apache.activemq:brokerName=a,type=Broker":{"UptimeMillis":0,"CurrentConnectionsCount":3}}}`,
+			wantErr: true,
+		},
+		"below the maximum connections": {
+			body:           `{"status":200,"value":{"org.apache.activemq:brokerName=a,type=Broker":{"UptimeMillis":1000,"CurrentConnectionsCount":999}}}`,
+			maxConnections: 1000,
+		},
+		"maximum connections reached": {
+			body:           `{"status":200,"value":{"org.apache.activemq:brokerName=a,type=Broker":{"UptimeMillis":1000,"CurrentConnectionsCount":1000}}}`,
+			maxConnections: 1000,
+			wantErr:        true,
+		},
+		"no connection limit": {
+			body: `{"status":200,"value":{"org.apache.activemq:brokerName=a,type=Broker":{"UptimeMillis":1000,"CurrentConnectionsCount":5000}}}`,
+		},
+		"no broker": {
+			body:    `{"status":200,"value":{}}`,
+			wantErr: true,
+		},
+		"jolokia error": {
+			body:    `{"status":404,"error":"javax.management.InstanceNotFoundException"}`,
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := mockAPI(t, nil, func(w http.ResponseWriter, r *http.Request) {
+				if !strings.HasPrefix(r.URL.Path, "/api/jolokia/read/org.apache.activemq:type=Broker") {
+					t.Errorf("unexpected path %q", r.URL.Path)
+				}
+
+				respondJSON(http.StatusOK, tt.body)(w, r)
+			})
+
+			assertCheck(t, NewActiveMQCheckWithMaxConnections("activemq", srv.URL+"/api/jolokia/", tt.maxConnections).Check, tt.wantErr)
+		})
+	}
+}
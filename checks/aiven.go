@@ -0,0 +1,45 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/pcordeiro/go-health"
+)
+
+var aivenAPI = "https://api.aiven.io/v1"
+
+// NewAivenCheck creates a check which calls the Aiven API to verify the service state is RUNNING.
+func NewAivenCheck(name, projectName, serviceName, apiToken string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			u := fmt.Sprintf("%s/project/%s/service/%s", aivenAPI, url.PathEscape(projectName), url.PathEscape(serviceName))
+
+			req, err := newRequest(ctx, http.MethodGet, u, nil, map[string]string{
+				"Authorization": "aivenv1 " + apiToken,
+				"Accept":        "application/json",
+			})
+			if err != nil {
+				return err
+			}
+
+			var res struct {
+				Service struct {
+					State string `json:"state"`
+				} `json:"service"`
+			}
+			if err := getJSON(req, &res); err != nil {
+				return err
+			}
+
+			if res.Service.State != "RUNNING" {
+				return fmt.Errorf("service %q is in state %s", serviceName, res.Service.State)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,33 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewAivenCheck(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		body    string
+		wantErr bool
+	}{
+		"running":     {status: http.StatusOK, body: `{"service":{"state":"RUNNING"}}`},
+		"rebuilding":  {status: http.StatusOK, body: `{"service":{"state":"REBUILDING"}}`, wantErr: true},
+		"powered off": {status: http.StatusOK, body: `{"service":{"state":"POWEROFF"}}`, wantErr: true},
+		"forbidden":   {status: http.StatusForbidden, body: `{}`, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &aivenAPI, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/project/acme/service/pg" || r.Header.Get("Authorization") != "aivenv1 token" {
+					t.Errorf("unexpected request to %s with authorization %q", r.URL.Path, r.Header.Get("Authorization"))
+				}
+
+				respondJSON(tt.status, tt.body)(w, r)
+			})
+
+			assertCheck(t, NewAivenCheck("aiven", "acme", "pg", "token").Check, tt.wantErr)
+		})
+	}
+}
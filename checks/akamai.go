@@ -0,0 +1,98 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// NewAkamaiCheck creates a check which performs a GET request to the Akamai fronted hostname and verifies the response
+// carries an Akamai X-Cache header, such as TCP_HIT or TCP_MISS, and the origin responds within a second.
+// hostname defaults to the https scheme when it has none.
+func NewAkamaiCheck(name, hostname string) health.Check {
+	return NewAkamaiCheckWithMaxOriginTime(name, hostname, time.Second)
+}
+
+// NewAkamaiCheckWithMaxOriginTime creates a check like NewAkamaiCheck which fails when the origin takes longer
+// than maxOriginTime to respond. The origin time is read from the origin entry of the Server-Timing header; when
+// the edge does not report it, e.g. on a cache hit or without Server-Timing enabled, the response time is used.
+func NewAkamaiCheckWithMaxOriginTime(name, hostname string, maxOriginTime time.Duration) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			u := hostname
+			if !strings.Contains(u, "://") {
+				u = "https://" + u
+			}
+
+			req, err := newRequest(ctx, http.MethodGet, u, nil, map[string]string{"Pragma": "akamai-x-cache-on"})
+			if err != nil {
+				return err
+			}
+
+			start := time.Now()
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return fmt.Errorf("request failed: %w", err)
+			}
+			res.Body.Close()
+			elapsed := time.Since(start)
+
+			if res.StatusCode >= http.StatusInternalServerError {
+				return fmt.Errorf("unexpected status code %d", res.StatusCode)
+			}
+
+			if !strings.HasPrefix(res.Header.Get("X-Cache"), "TCP_") {
+				return errors.New("response has no Akamai X-Cache header")
+			}
+
+			if origin, ok := serverTiming(res.Header, "origin"); ok {
+				if origin > maxOriginTime {
+					return fmt.Errorf("origin took %s, expected at most %s", origin, maxOriginTime)
+				}
+
+				return nil
+			}
+
+			if elapsed > maxOriginTime {
+				return fmt.Errorf("response took %s, expected at most %s", elapsed, maxOriginTime)
+			}
+
+			return nil
+		},
+	}
+}
+
+// serverTiming returns the duration of the named metric of the Server-Timing header, e.g. "origin; dur=123.4".
+func serverTiming(header http.Header, metric string) (time.Duration, bool) {
+	for _, value := range header.Values("Server-Timing") {
+		for _, entry := range strings.Split(value, ",") {
+			params := strings.Split(entry, ";")
+			if strings.TrimSpace(params[0]) != metric {
+				continue
+			}
+
+			for _, p := range params[1:] {
+				k, v, _ := strings.Cut(strings.TrimSpace(p), "=")
+				if k != "dur" {
+					continue
+				}
+
+				ms, err := strconv.ParseFloat(v, 64)
+				if err != nil {
+					return 0, false
+				}
+
+				return time.Duration(ms * float64(time.Millisecond)), true
+			}
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,48 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewAkamaiCheck(t *testing.T) {
+	tests := map[string]struct {
+		status        int
+		xCache        string
+		serverTiming  string
+		delay         time.Duration
+		maxOriginTime time.Duration
+		wantErr       bool
+	}{
+		"cache hit":          {status: http.StatusOK, xCache: "TCP_HIT from a23-1-2-3", maxOriginTime: time.Second},
+		"fast origin":        {status: http.StatusOK, xCache: "TCP_MISS", serverTiming: "cdn-cache; desc=MISS, origin; dur=120", maxOriginTime: time.Second},
+		"slow origin":        {status: http.StatusOK, xCache: "TCP_MISS", serverTiming: "cdn-cache; desc=MISS, origin; dur=1500", maxOriginTime: time.Second, wantErr: true},
+		"origin threshold":   {status: http.StatusOK, xCache: "TCP_MISS", serverTiming: "origin; dur=120", maxOriginTime: 100 * time.Millisecond, wantErr: true},
+		"slow response":      {status: http.StatusOK, xCache: "TCP_MISS", delay: 30 * time.Millisecond, maxOriginTime: 10 * time.Millisecond, wantErr: true},
+		"not behind akamai":  {status: http.StatusOK, maxOriginTime: time.Second, wantErr: true},
+		"server error":       {status: http.StatusBadGateway, xCache: "TCP_MISS", maxOriginTime: time.Second, wantErr: true},
+		"client error is up": {status: http.StatusNotFound, xCache: "TCP_MISS", maxOriginTime: time.Second},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := mockAPI(t, nil, func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Pragma") != "akamai-x-cache-on" {
+					t.Errorf("unexpected pragma %q", r.Header.Get("Pragma"))
+				}
+
+				time.Sleep(tt.delay)
+				if tt.xCache != "" {
+					w.Header().Set("X-Cache", tt.xCache)
+				}
+				if tt.serverTiming != "" {
+					w.Header().Set("Server-Timing", tt.serverTiming)
+				}
+				w.WriteHeader(tt.status)
+			})
+
+			assertCheck(t, NewAkamaiCheckWithMaxOriginTime("akamai", srv.URL, tt.maxOriginTime).Check, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package checks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pcordeiro/go-health"
+)
+
+var amplitudeAPI = "https://api2.amplitude.com"
+
+// NewAmplitudeCheck creates a check which sends a minimal batch to the Amplitude HTTP API v2 and verifies the
+// response code is 200.
+func NewAmplitudeCheck(name, apiKey string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			body, err := json.Marshal(map[string]any{
+				"api_key": apiKey,
+				"events": []map[string]string{
+					{"user_id": "go-health", "event_type": "go-health check"},
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("could not encode request: %w", err)
+			}
+
+			req, err := newRequest(ctx, http.MethodPost, amplitudeAPI+"/2/httpapi", bytes.NewReader(body), map[string]string{
+				"Content-Type": "application/json",
+				"Accept":       "*/*",
+			})
+			if err != nil {
+				return err
+			}
+
+			var res struct {
+				Code  int    `json:"code"`
+				Error string `json:"error"`
+			}
+			if err := getJSON(req, &res); err != nil {
+				return err
+			}
+
+			if res.Code != http.StatusOK {
+				return fmt.Errorf("batch was rejected with code %d: %s", res.Code, res.Error)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,41 @@
+package checks
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestNewAmplitudeCheck(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		body    string
+		wantErr bool
+	}{
+		"accepted":        {status: http.StatusOK, body: `{"code":200,"events_ingested":1}`},
+		"rejected code":   {status: http.StatusOK, body: `{"code":400,"error":"Invalid API key"}`, wantErr: true},
+		"invalid request": {status: http.StatusBadRequest, body: `{"code":400,"error":"Invalid API key"}`, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &amplitudeAPI, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/2/httpapi" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+
+				var batch struct {
+					APIKey string              `json:"api_key"`
+					Events []map[string]string `json:"events"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&batch); err != nil || batch.APIKey != "key" || len(batch.Events) != 1 {
+					t.Errorf("unexpected batch %+v: %v", batch, err)
+				}
+
+				respondJSON(tt.status, tt.body)(w, r)
+			})
+
+			assertCheck(t, NewAmplitudeCheck("amplitude", "key").Check, tt.wantErr)
+		})
+	}
+}
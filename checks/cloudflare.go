@@ -0,0 +1,56 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// CloudflareDNSRecord holds the parts of a Cloudflare DNS record used by NewCloudflareCheck.
+type CloudflareDNSRecord struct {
+	Type    string
+	Name    string
+	Content string
+}
+
+// CloudflareClient is the part of the Cloudflare API used by NewCloudflareCheck. It is implemented by a thin
+// adapter around the Cloudflare SDK, keeping this module free of that dependency.
+type CloudflareClient interface {
+	// ListDNSRecords returns the DNS records of the zone with the given name.
+	ListDNSRecords(ctx context.Context, zoneID, name string) ([]CloudflareDNSRecord, error)
+}
+
+// NewCloudflareCheck creates a check which verifies the DNS record exists in the zone and, if expected values
+// are given, that each of them is the content of one of its records.
+func NewCloudflareCheck(name string, client CloudflareClient, zone, record string, expected ...string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			records, err := client.ListDNSRecords(ctx, zone, record)
+			if err != nil {
+				return fmt.Errorf("could not list DNS records of %q: %w", record, err)
+			}
+
+			if len(records) == 0 {
+				return fmt.Errorf("DNS record %q does not exist", record)
+			}
+
+			for _, want := range expected {
+				found := false
+				for _, r := range records {
+					if r.Content == want {
+						found = true
+						break
+					}
+				}
+
+				if !found {
+					return fmt.Errorf("DNS record %q has no value %q", record, want)
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type fakeCloudflare struct {
+	records []CloudflareDNSRecord
+	err     error
+}
+
+func (f fakeCloudflare) ListDNSRecords(context.Context, string, string) ([]CloudflareDNSRecord, error) {
+	return f.records, f.err
+}
+
+func TestNewCloudflareCheck(t *testing.T) {
+	records := []CloudflareDNSRecord{
+		{Type: "A", Name: "api.example.com", Content: "192.0.2.1"},
+		{Type: "A", Name: "api.example.com", Content: "192.0.2.2"},
+	}
+
+	tests := map[string]struct {
+		client   fakeCloudflare
+		expected []string
+		wantErr  bool
+	}{
+		"exists":         {client: fakeCloudflare{records: records}},
+		"expected value": {client: fakeCloudflare{records: records}, expected: []string{"192.0.2.2", "192.0.2.1"}},
+		"missing value":  {client: fakeCloudflare{records: records}, expected: []string{"192.0.2.3"}, wantErr: true},
+		"missing record": {client: fakeCloudflare{}, wantErr: true},
+		"api error":      {client: fakeCloudflare{err: errors.New("unauthorized")}, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			check := NewCloudflareCheck("cloudflare", tt.client, "zone", "api.example.com", tt.expected...)
+			assertCheck(t, check.Check, tt.wantErr)
+		})
+	}
+}
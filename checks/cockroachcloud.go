@@ -0,0 +1,49 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pcordeiro/go-health"
+)
+
+var cockroachCloudAPI = "https://cockroachlabs.cloud/api/v1"
+
+// NewCockroachCloudCheck creates a check which calls the CockroachCloud API to verify the cluster is created
+// and serving. Clusters with a running operation, such as CRDB_MAJOR_UPGRADE_RUNNING, are considered healthy
+// while failed operations are not.
+func NewCockroachCloudCheck(name, clusterID, apiKey string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			req, err := newRequest(ctx, http.MethodGet, cockroachCloudAPI+"/clusters/"+url.PathEscape(clusterID), nil, map[string]string{
+				"Authorization": "Bearer " + apiKey,
+				"Accept":        "application/json",
+			})
+			if err != nil {
+				return err
+			}
+
+			var c struct {
+				State           string `json:"state"`
+				OperationStatus string `json:"operation_status"`
+			}
+			if err := getJSON(req, &c); err != nil {
+				return err
+			}
+
+			if c.State != "CREATED" {
+				return fmt.Errorf("cluster %q is in state %s", clusterID, c.State)
+			}
+
+			if strings.HasSuffix(c.OperationStatus, "_FAILED") {
+				return fmt.Errorf("cluster %q operation status is %s", clusterID, c.OperationStatus)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewCockroachCloudCheck(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		body    string
+		wantErr bool
+	}{
+		"created":          {status: http.StatusOK, body: `{"state":"CREATED","operation_status":"CLUSTER_STATUS_UNSPECIFIED"}`},
+		"upgrading":        {status: http.StatusOK, body: `{"state":"CREATED","operation_status":"CRDB_MAJOR_UPGRADE_RUNNING"}`},
+		"operation failed": {status: http.StatusOK, body: `{"state":"CREATED","operation_status":"CRDB_MAJOR_UPGRADE_FAILED"}`, wantErr: true},
+		"creation failed":  {status: http.StatusOK, body: `{"state":"CREATION_FAILED"}`, wantErr: true},
+		"unknown cluster":  {status: http.StatusNotFound, body: `{}`, wantErr: true},
+		"invalid response": {status: http.StatusOK, body: `not json`, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &cockroachCloudAPI, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/clusters/c1" || r.Header.Get("Authorization") != "Bearer key" {
+					t.Errorf("unexpected request to %s with authorization %q", r.URL.Path, r.Header.Get("Authorization"))
+				}
+
+				respondJSON(tt.status, tt.body)(w, r)
+			})
+
+			assertCheck(t, NewCockroachCloudCheck("cockroach", "c1", "key").Check, tt.wantErr)
+		})
+	}
+}
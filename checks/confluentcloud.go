@@ -0,0 +1,44 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/pcordeiro/go-health"
+)
+
+var confluentCloudAPI = "https://api.confluent.cloud"
+
+// NewConfluentCloudCheck creates a check which calls the Confluent Cloud API to verify the Kafka cluster is up,
+// which the API reports as the PROVISIONED phase.
+func NewConfluentCloudCheck(name, environmentID, clusterID, apiKey, apiSecret string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			u := fmt.Sprintf("%s/cmk/v2/clusters/%s?environment=%s", confluentCloudAPI, url.PathEscape(clusterID), url.QueryEscape(environmentID))
+
+			req, err := newRequest(ctx, http.MethodGet, u, nil, map[string]string{"Accept": "application/json"})
+			if err != nil {
+				return err
+			}
+			req.SetBasicAuth(apiKey, apiSecret)
+
+			var res struct {
+				Status struct {
+					Phase string `json:"phase"`
+				} `json:"status"`
+			}
+			if err := getJSON(req, &res); err != nil {
+				return err
+			}
+
+			if res.Status.Phase != "PROVISIONED" {
+				return fmt.Errorf("cluster %q is %s", clusterID, res.Status.Phase)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewConfluentCloudCheck(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		body    string
+		wantErr bool
+	}{
+		"provisioned":  {status: http.StatusOK, body: `{"status":{"phase":"PROVISIONED"}}`},
+		"provisioning": {status: http.StatusOK, body: `{"status":{"phase":"PROVISIONING"}}`, wantErr: true},
+		"failed":       {status: http.StatusOK, body: `{"status":{"phase":"FAILED"}}`, wantErr: true},
+		"unauthorized": {status: http.StatusUnauthorized, body: `{}`, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &confluentCloudAPI, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.RequestURI() != "/cmk/v2/clusters/lkc-1?environment=env-1" {
+					t.Errorf("unexpected request %q", r.URL.RequestURI())
+				}
+				if key, secret, _ := r.BasicAuth(); key != "key" || secret != "secret" {
+					t.Errorf("unexpected credentials %q:%q", key, secret)
+				}
+
+				respondJSON(tt.status, tt.body)(w, r)
+			})
+
+			assertCheck(t, NewConfluentCloudCheck("confluent", "env-1", "lkc-1", "key", "secret").Check, tt.wantErr)
+		})
+	}
+}
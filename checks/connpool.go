@@ -0,0 +1,22 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// CheckConnPool creates a check which reports a degradation when more than maxWait goroutines wait for a connection
+// of the pool, as returned by statsFn, indicating pool exhaustion.
+func CheckConnPool(statsFn func() (idle, active, wait int), maxWait int) health.CheckFunc {
+	return func(ctx context.Context) error {
+		idle, active, wait := statsFn()
+
+		if wait > maxWait {
+			return fmt.Errorf("%w: %d goroutines wait for a connection (%d active, %d idle), expected at most %d", health.ErrDegraded, wait, active, idle, maxWait)
+		}
+
+		return nil
+	}
+}
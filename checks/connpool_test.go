@@ -0,0 +1,36 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pcordeiro/go-health"
+)
+
+func TestCheckConnPool(t *testing.T) {
+	tests := map[string]struct {
+		idle, active, wait int
+		wantErr            bool
+	}{
+		"idle connections":  {idle: 5, active: 5},
+		"waiting below max": {idle: 0, active: 10, wait: 2},
+		"waiting at max":    {idle: 0, active: 10, wait: 3},
+		"exhausted":         {idle: 0, active: 10, wait: 4, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stats := func() (int, int, int) { return tt.idle, tt.active, tt.wait }
+
+			err := CheckConnPool(stats, 3)(context.Background())
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v, got %v", tt.wantErr, err)
+			}
+			if err != nil && !errors.Is(err, health.ErrDegraded) {
+				t.Errorf("expected a degradation, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// CheckDBRole creates a check which fails unless the role of the connected database node, as returned by roleFn,
+// is the expected one, e.g. "primary" for a write path.
+func CheckDBRole(roleFn func(ctx context.Context) (string, error), expected string) health.CheckFunc {
+	return func(ctx context.Context) error {
+		role, err := roleFn(ctx)
+		if err != nil {
+			return fmt.Errorf("could not get database role: %w", err)
+		}
+
+		if role != expected {
+			return fmt.Errorf("database node is %s, expected %s", role, expected)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,37 @@
+package checks
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+)
+
+func TestCheckDBRole(t *testing.T) {
+	tests := map[string]struct {
+		dsn     string
+		wantErr bool
+	}{
+		"primary":     {dsn: "postgres://db?value=primary"},
+		"replica":     {dsn: "postgres://db?value=replica", wantErr: true},
+		"unreachable": {dsn: "postgres://unreachable", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, err := sql.Open(fakeDriverName, tt.dsn)
+			if err != nil {
+				t.Fatalf("could not open database: %v", err)
+			}
+			defer db.Close()
+
+			role := func(ctx context.Context) (string, error) {
+				var r string
+				err := db.QueryRowContext(ctx, "SELECT CASE WHEN pg_is_in_recovery() THEN 'replica' ELSE 'primary' END").Scan(&r)
+
+				return r, err
+			}
+
+			assertCheck(t, CheckDBRole(role, "primary"), tt.wantErr)
+		})
+	}
+}
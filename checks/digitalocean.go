@@ -0,0 +1,77 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/pcordeiro/go-health"
+)
+
+var digitalOceanAPI = "https://api.digitalocean.com/v2"
+
+// NewDigitalOceanAppCheck creates a check which calls the DigitalOcean API to verify the active deployment of the
+// App Platform app is active and all its components are healthy.
+func NewDigitalOceanAppCheck(name, appID, apiToken string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			path := "/apps/" + url.PathEscape(appID)
+
+			var app struct {
+				App struct {
+					ActiveDeployment *struct {
+						ID    string `json:"id"`
+						Phase string `json:"phase"`
+					} `json:"active_deployment"`
+				} `json:"app"`
+			}
+			if err := digitalOceanGet(ctx, apiToken, path, &app); err != nil {
+				return err
+			}
+
+			d := app.App.ActiveDeployment
+			if d == nil {
+				return errors.New("app has no active deployment")
+			}
+
+			if d.Phase != "ACTIVE" {
+				return fmt.Errorf("deployment %q is %s", d.ID, d.Phase)
+			}
+
+			var status struct {
+				AppHealth struct {
+					Components []struct {
+						Name  string `json:"name"`
+						State string `json:"state"`
+					} `json:"components"`
+				} `json:"app_health"`
+			}
+			if err := digitalOceanGet(ctx, apiToken, path+"/health", &status); err != nil {
+				return err
+			}
+
+			for _, c := range status.AppHealth.Components {
+				if c.State != "HEALTHY" {
+					return fmt.Errorf("component %q is %s", c.Name, c.State)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// digitalOceanGet calls the DigitalOcean API at path and decodes the response into v.
+func digitalOceanGet(ctx context.Context, apiToken, path string, v any) error {
+	req, err := newRequest(ctx, http.MethodGet, digitalOceanAPI+path, nil, map[string]string{
+		"Authorization": "Bearer " + apiToken,
+	})
+	if err != nil {
+		return err
+	}
+
+	return getJSON(req, v)
+}
@@ -0,0 +1,52 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewDigitalOceanAppCheck(t *testing.T) {
+	const (
+		active  = `{"app":{"active_deployment":{"id":"dep-1","phase":"ACTIVE"}}}`
+		healthy = `{"app_health":{"components":[{"name":"web","state":"HEALTHY"},{"name":"worker","state":"HEALTHY"}]}}`
+	)
+
+	tests := map[string]struct {
+		app, health string
+		wantErr     bool
+	}{
+		"healthy": {app: active, health: healthy},
+		"no active deployment": {
+			app:     `{"app":{}}`,
+			health:  healthy,
+			wantErr: true,
+		},
+		"deployment not active": {
+			app:     `{"app":{"active_deployment":{"id":"dep-1","phase":"ERROR"}}}`,
+			health:  healthy,
+			wantErr: true,
+		},
+		"unhealthy component": {
+			app:     active,
+			health:  `{"app_health":{"components":[{"name":"web","state":"HEALTHY"},{"name":"worker","state":"UNHEALTHY"}]}}`,
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &digitalOceanAPI, func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Authorization"); got != "Bearer token" {
+					t.Errorf("unexpected authorization %q", got)
+				}
+
+				routes(map[string]http.HandlerFunc{
+					"/apps/app-1":        respondJSON(http.StatusOK, tt.app),
+					"/apps/app-1/health": respondJSON(http.StatusOK, tt.health),
+				})(w, r)
+			})
+
+			assertCheck(t, NewDigitalOceanAppCheck("digitalocean", "app-1", "token").Check, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// lookupSRV resolves SRV records, it is a variable so the resolver can be replaced.
+var lookupSRV = net.DefaultResolver.LookupSRV
+
+// CheckSRV creates a check which resolves the SRV records of the given service and fails
+// if fewer than minTargets records are returned.
+func CheckSRV(service, proto, name string, minTargets int) health.CheckFunc {
+	return func(ctx context.Context) error {
+		_, addrs, err := lookupSRV(ctx, service, proto, name)
+		if err != nil {
+			return fmt.Errorf("could not resolve SRV records: %w", err)
+		}
+
+		if len(addrs) < minTargets {
+			return fmt.Errorf("resolved %d SRV records, expected at least %d", len(addrs), minTargets)
+		}
+
+		return nil
+	}
+}
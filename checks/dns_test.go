@@ -0,0 +1,37 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+)
+
+func TestCheckSRV(t *testing.T) {
+	tests := map[string]struct {
+		addrs   []*net.SRV
+		err     error
+		wantErr bool
+	}{
+		"enough targets":   {addrs: []*net.SRV{{Target: "a."}, {Target: "b."}}},
+		"too few targets":  {addrs: []*net.SRV{{Target: "a."}}, wantErr: true},
+		"resolution fails": {err: errors.New("no such host"), wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			original := lookupSRV
+			t.Cleanup(func() { lookupSRV = original })
+
+			lookupSRV = func(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+				if service != "ldap" || proto != "tcp" || name != "example.com" {
+					t.Errorf("unexpected lookup of %s %s %s", service, proto, name)
+				}
+
+				return "", tt.addrs, tt.err
+			}
+
+			assertCheck(t, CheckSRV("ldap", "tcp", "example.com", 2), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// readEntropyAvail reads the available entropy, it is a variable so the source can be replaced.
+var readEntropyAvail = func() ([]byte, error) {
+	return os.ReadFile("/proc/sys/kernel/random/entropy_avail")
+}
+
+// CheckEntropy creates a check which fails when the kernel entropy pool holds less than min bits.
+func CheckEntropy(min int) health.CheckFunc {
+	return func(ctx context.Context) error {
+		raw, err := readEntropyAvail()
+		if err != nil {
+			return fmt.Errorf("could not read available entropy: %w", err)
+		}
+
+		avail, err := strconv.Atoi(strings.TrimSpace(string(raw)))
+		if err != nil {
+			return fmt.Errorf("invalid available entropy %q", raw)
+		}
+
+		if avail < min {
+			return fmt.Errorf("available entropy is %d bits, expected at least %d", avail, min)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,34 @@
+package checks
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckEntropy(t *testing.T) {
+	tests := map[string]struct {
+		raw     string
+		err     error
+		wantErr bool
+	}{
+		"enough":     {raw: "3520\n"},
+		"too low":    {raw: "128\n", wantErr: true},
+		"invalid":    {raw: "lots", wantErr: true},
+		"unreadable": {err: errors.New("permission denied"), wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			original := readEntropyAvail
+			t.Cleanup(func() { readEntropyAvail = original })
+
+			readEntropyAvail = func() ([]byte, error) { return []byte(tt.raw), tt.err }
+
+			assertCheck(t, CheckEntropy(256), tt.wantErr)
+		})
+	}
+
+	t.Run("kernel pool", func(t *testing.T) {
+		assertCheck(t, CheckEntropy(0), false)
+	})
+}
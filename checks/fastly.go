@@ -0,0 +1,49 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// FastlyBackend holds the parts of a Fastly origin backend used by NewFastlyCheck.
+type FastlyBackend struct {
+	Name    string
+	Healthy bool
+}
+
+// FastlyClient is the part of the Fastly API used by NewFastlyCheck. It is implemented by a thin adapter around
+// the Fastly SDK, keeping this module free of that dependency.
+type FastlyClient interface {
+	// ActiveVersion returns the number of the active version of the service.
+	ActiveVersion(ctx context.Context, serviceID string) (int, error)
+	// ListBackends returns the origin backends of the given service version along with their health.
+	ListBackends(ctx context.Context, serviceID string, version int) ([]FastlyBackend, error)
+}
+
+// NewFastlyCheck creates a check which verifies the active version of the service has at least one healthy origin backend.
+func NewFastlyCheck(name string, client FastlyClient, serviceID string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			version, err := client.ActiveVersion(ctx, serviceID)
+			if err != nil {
+				return fmt.Errorf("could not get active version of service %q: %w", serviceID, err)
+			}
+
+			backends, err := client.ListBackends(ctx, serviceID, version)
+			if err != nil {
+				return fmt.Errorf("could not list backends of service %q version %d: %w", serviceID, version, err)
+			}
+
+			for _, b := range backends {
+				if b.Healthy {
+					return nil
+				}
+			}
+
+			return fmt.Errorf("service %q version %d has no healthy backend out of %d", serviceID, version, len(backends))
+		},
+	}
+}
@@ -0,0 +1,61 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type fakeFastly struct {
+	version    int
+	backends   []FastlyBackend
+	versionErr error
+	listErr    error
+	listed     int
+}
+
+func (f *fakeFastly) ActiveVersion(context.Context, string) (int, error) {
+	return f.version, f.versionErr
+}
+
+func (f *fakeFastly) ListBackends(_ context.Context, _ string, version int) ([]FastlyBackend, error) {
+	f.listed = version
+	return f.backends, f.listErr
+}
+
+func TestNewFastlyCheck(t *testing.T) {
+	tests := map[string]struct {
+		client  *fakeFastly
+		wantErr bool
+	}{
+		"one healthy backend": {
+			client: &fakeFastly{version: 7, backends: []FastlyBackend{{Name: "eu", Healthy: false}, {Name: "us", Healthy: true}}},
+		},
+		"no healthy backend": {
+			client:  &fakeFastly{version: 7, backends: []FastlyBackend{{Name: "eu"}, {Name: "us"}}},
+			wantErr: true,
+		},
+		"no backend": {
+			client:  &fakeFastly{version: 7},
+			wantErr: true,
+		},
+		"version error": {
+			client:  &fakeFastly{versionErr: errors.New("unauthorized")},
+			wantErr: true,
+		},
+		"backends error": {
+			client:  &fakeFastly{version: 7, listErr: errors.New("unavailable")},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assertCheck(t, NewFastlyCheck("fastly", tt.client, "svc").Check, tt.wantErr)
+
+			if tt.client.versionErr == nil && tt.client.listed != tt.client.version {
+				t.Errorf("expected the backends of the active version %d, got %d", tt.client.version, tt.client.listed)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// CheckFeature creates a check which fails unless present reports the named feature, e.g. a component gated
+// by a build tag or CGO, is part of the binary.
+func CheckFeature(name string, present func() bool) health.CheckFunc {
+	return func(ctx context.Context) error {
+		if !present() {
+			return fmt.Errorf("feature %q is not present in this build", name)
+		}
+
+		return nil
+	}
+}
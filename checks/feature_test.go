@@ -0,0 +1,8 @@
+package checks
+
+import "testing"
+
+func TestCheckFeature(t *testing.T) {
+	assertCheck(t, CheckFeature("sqlite", func() bool { return true }), false)
+	assertCheck(t, CheckFeature("sqlite", func() bool { return false }), true)
+}
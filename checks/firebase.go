@@ -0,0 +1,41 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// NewFirebaseRTDBCheck creates a check which calls the shallow root of the Firebase Realtime Database and expects
+// a 200 response, or a 401 one meaning authentication is required but the database is reachable.
+// httpClient defaults to http.DefaultClient when nil.
+func NewFirebaseRTDBCheck(name, dbURL string, httpClient *http.Client) health.Check {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			req, err := newRequest(ctx, http.MethodGet, strings.TrimSuffix(dbURL, "/")+"/.json?shallow=true", nil, nil)
+			if err != nil {
+				return err
+			}
+
+			res, err := httpClient.Do(req)
+			if err != nil {
+				return fmt.Errorf("request failed: %w", err)
+			}
+			defer res.Body.Close()
+
+			if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusUnauthorized {
+				return fmt.Errorf("unexpected status code %d", res.StatusCode)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewFirebaseRTDBCheck(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		wantErr bool
+	}{
+		"public":                  {status: http.StatusOK},
+		"authentication required": {status: http.StatusUnauthorized},
+		"unknown database":        {status: http.StatusNotFound, wantErr: true},
+		"outage":                  {status: http.StatusServiceUnavailable, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := mockAPI(t, nil, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/.json" || r.URL.Query().Get("shallow") != "true" {
+					t.Errorf("unexpected request %s", r.URL)
+				}
+				respondJSON(tt.status, `{}`)(w, r)
+			})
+
+			assertCheck(t, NewFirebaseRTDBCheck("firebase", srv.URL+"/", srv.Client()).Check, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// ErrPermissionDenied is returned by a FirestoreClient when the query is denied, which proves Firestore is reachable.
+var ErrPermissionDenied = errors.New("permission denied")
+
+// FirestoreClient is the part of *firestore.Client used by NewFirestoreCheck. It is implemented by a thin adapter
+// around the Firestore client, keeping this module free of that dependency.
+type FirestoreClient interface {
+	// GetFirst fetches at most one document of the collection, i.e.
+	// client.Collection(collection).Limit(1).Documents(ctx).GetAll(), and returns an error wrapping
+	// ErrPermissionDenied when the query fails with codes.PermissionDenied.
+	GetFirst(ctx context.Context, collection string) error
+}
+
+// NewFirestoreCheck creates a check which fetches a document of the collection. A denied permission is acceptable
+// as Firestore was reached, any other error is not.
+func NewFirestoreCheck(name string, client FirestoreClient, collection string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			err := client.GetFirst(ctx, collection)
+			if err != nil && !errors.Is(err, ErrPermissionDenied) {
+				return fmt.Errorf("could not query collection %q: %w", collection, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// fakeFirestoreClient records the queried collection and returns err.
+type fakeFirestoreClient struct {
+	collection string
+	err        error
+}
+
+func (c *fakeFirestoreClient) GetFirst(_ context.Context, collection string) error {
+	c.collection = collection
+	return c.err
+}
+
+func TestNewFirestoreCheck(t *testing.T) {
+	tests := map[string]struct {
+		err     error
+		wantErr bool
+	}{
+		"documents fetched": {},
+		"permission denied": {err: fmt.Errorf("rpc error: %w", ErrPermissionDenied)},
+		"unavailable":       {err: errors.New("rpc error: code = Unavailable"), wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &fakeFirestoreClient{err: tt.err}
+
+			assertCheck(t, NewFirestoreCheck("firestore", client, "health").Check, tt.wantErr)
+
+			if client.collection != "health" {
+				t.Errorf("expected the collection to be queried, got %q", client.collection)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// FlagsmithClient is the part of *flagsmith.Client used by NewFlagsmithCheck, F being flagsmith.Flags.
+// It keeps this package free of the Flagsmith dependency.
+type FlagsmithClient[F any] interface {
+	GetEnvironmentFlags(ctx context.Context) (F, error)
+}
+
+// NewFlagsmithCheck creates a check which fetches the environment flags, confirming the SDK can reach the
+// Flagsmith API. With the Flagsmith SDK it is used as
+//
+//	checks.NewFlagsmithCheck[flagsmith.Flags]("flagsmith", client)
+func NewFlagsmithCheck[F any](name string, client FlagsmithClient[F]) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			if _, err := client.GetEnvironmentFlags(ctx); err != nil {
+				return fmt.Errorf("could not get environment flags: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
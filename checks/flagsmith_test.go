@@ -0,0 +1,24 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// fakeFlags stands for flagsmith.Flags.
+type fakeFlags struct{}
+
+// fakeFlagsmithClient returns err when fetching the environment flags.
+type fakeFlagsmithClient struct {
+	err error
+}
+
+func (c fakeFlagsmithClient) GetEnvironmentFlags(context.Context) (fakeFlags, error) {
+	return fakeFlags{}, c.err
+}
+
+func TestNewFlagsmithCheck(t *testing.T) {
+	assertCheck(t, NewFlagsmithCheck[fakeFlags]("flagsmith", fakeFlagsmithClient{}).Check, false)
+	assertCheck(t, NewFlagsmithCheck[fakeFlags]("flagsmith", fakeFlagsmithClient{err: errors.New("401 Unauthorized")}).Check, true)
+}
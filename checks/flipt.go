@@ -0,0 +1,38 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// NewFliptCheck creates a check which calls the Flipt HTTP health endpoint and verifies the service is SERVING.
+func NewFliptCheck(name, fliptURL string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			req, err := newRequest(ctx, http.MethodGet, strings.TrimSuffix(fliptURL, "/")+"/health", nil, map[string]string{
+				"Accept": "application/json",
+			})
+			if err != nil {
+				return err
+			}
+
+			var res struct {
+				Status string `json:"status"`
+			}
+			if err := getJSON(req, &res); err != nil {
+				return err
+			}
+
+			if res.Status != "SERVING" {
+				return fmt.Errorf("Flipt status is %q", res.Status)
+			}
+
+			return nil
+		},
+	}
+}
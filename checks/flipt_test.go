@@ -0,0 +1,31 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewFliptCheck(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		body    string
+		wantErr bool
+	}{
+		"serving":     {status: http.StatusOK, body: `{"status":"SERVING"}`},
+		"not serving": {status: http.StatusOK, body: `{"status":"NOT_SERVING"}`, wantErr: true},
+		"unavailable": {status: http.StatusServiceUnavailable, body: `{}`, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := mockAPI(t, nil, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/health" {
+					t.Errorf("unexpected path %q", r.URL.Path)
+				}
+				respondJSON(tt.status, tt.body)(w, r)
+			})
+
+			assertCheck(t, NewFliptCheck("flipt", srv.URL+"/").Check, tt.wantErr)
+		})
+	}
+}
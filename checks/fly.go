@@ -0,0 +1,47 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/pcordeiro/go-health"
+)
+
+var flyMachinesAPI = "https://api.machines.dev/v1"
+
+// NewFlyCheck creates a check which calls the Fly.io Machines API to verify every machine of the app is started.
+func NewFlyCheck(name, appName, apiToken string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			req, err := newRequest(ctx, http.MethodGet, flyMachinesAPI+"/apps/"+url.PathEscape(appName)+"/machines", nil, map[string]string{
+				"Authorization": "Bearer " + apiToken,
+			})
+			if err != nil {
+				return err
+			}
+
+			var machines []struct {
+				ID    string `json:"id"`
+				State string `json:"state"`
+			}
+			if err := getJSON(req, &machines); err != nil {
+				return err
+			}
+
+			if len(machines) == 0 {
+				return fmt.Errorf("app %q has no machine", appName)
+			}
+
+			for _, m := range machines {
+				if m.State != "started" {
+					return fmt.Errorf("machine %q of app %q is %s", m.ID, appName, m.State)
+				}
+			}
+
+			return nil
+		},
+	}
+}
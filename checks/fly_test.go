@@ -0,0 +1,42 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewFlyCheck(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		body    string
+		wantErr bool
+	}{
+		"all started": {
+			status: http.StatusOK,
+			body:   `[{"id":"m1","state":"started"},{"id":"m2","state":"started"}]`,
+		},
+		"one stopped": {
+			status:  http.StatusOK,
+			body:    `[{"id":"m1","state":"started"},{"id":"m2","state":"stopped"}]`,
+			wantErr: true,
+		},
+		"no machine":  {status: http.StatusOK, body: `[]`, wantErr: true},
+		"unknown app": {status: http.StatusNotFound, body: `{"error":"app not found"}`, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &flyMachinesAPI, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/apps/my-app/machines" {
+					t.Errorf("unexpected path %q", r.URL.Path)
+				}
+				if got := r.Header.Get("Authorization"); got != "Bearer token" {
+					t.Errorf("unexpected authorization %q", got)
+				}
+				respondJSON(tt.status, tt.body)(w, r)
+			})
+
+			assertCheck(t, NewFlyCheck("fly", "my-app", "token").Check, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// ConnStateReporter is the part of *grpc.ClientConn used by CheckConnState, S being connectivity.State.
+// It keeps this package free of the gRPC dependency.
+type ConnStateReporter[S fmt.Stringer] interface {
+	GetState() S
+	WaitForStateChange(ctx context.Context, sourceState S) bool
+}
+
+// CheckConnState creates a check which fails unless the connection is READY or IDLE. A CONNECTING connection
+// is given until the context is done to settle. With gRPC it is used as
+//
+//	checks.CheckConnState[connectivity.State](conn)
+func CheckConnState[S fmt.Stringer](conn ConnStateReporter[S]) health.CheckFunc {
+	return func(ctx context.Context) error {
+		for {
+			state := conn.GetState()
+
+			switch state.String() {
+			case "READY", "IDLE":
+				return nil
+			case "CONNECTING":
+				if !conn.WaitForStateChange(ctx, state) {
+					return fmt.Errorf("connection is still %s: %w", state, ctx.Err())
+				}
+			default:
+				return fmt.Errorf("connection is %s", state)
+			}
+		}
+	}
+}
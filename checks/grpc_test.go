@@ -0,0 +1,57 @@
+package checks
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type connState string
+
+func (s connState) String() string { return string(s) }
+
+// fakeConn reports the states in order, moving to the next one on every WaitForStateChange.
+type fakeConn struct {
+	states []connState
+}
+
+func (c *fakeConn) GetState() connState {
+	return c.states[0]
+}
+
+func (c *fakeConn) WaitForStateChange(ctx context.Context, _ connState) bool {
+	if len(c.states) == 1 {
+		<-ctx.Done()
+		return false
+	}
+
+	c.states = c.states[1:]
+	return true
+}
+
+func TestCheckConnState(t *testing.T) {
+	tests := map[string]struct {
+		states  []connState
+		wantErr bool
+	}{
+		"ready":                   {states: []connState{"READY"}},
+		"idle":                    {states: []connState{"IDLE"}},
+		"connecting then ready":   {states: []connState{"CONNECTING", "READY"}},
+		"transient failure":       {states: []connState{"TRANSIENT_FAILURE"}, wantErr: true},
+		"shutdown":                {states: []connState{"SHUTDOWN"}, wantErr: true},
+		"connecting then failure": {states: []connState{"CONNECTING", "TRANSIENT_FAILURE"}, wantErr: true},
+		"stuck connecting":        {states: []connState{"CONNECTING"}, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			err := CheckConnState[connState](&fakeConn{states: tt.states})(ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/pcordeiro/go-health"
+)
+
+var herokuAPI = "https://api.heroku.com"
+
+// NewHerokuCheck creates a check which calls the Heroku Platform API to verify the dyno formation quantity
+// of the app matches the number of dynos up.
+func NewHerokuCheck(name, appName, dynoFormation, apiToken string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			path := "/apps/" + url.PathEscape(appName)
+
+			var formation struct {
+				Quantity int `json:"quantity"`
+			}
+			if err := herokuGet(ctx, apiToken, path+"/formation/"+url.PathEscape(dynoFormation), &formation); err != nil {
+				return err
+			}
+
+			var dynos []struct {
+				Name  string `json:"name"`
+				Type  string `json:"type"`
+				State string `json:"state"`
+			}
+			if err := herokuGet(ctx, apiToken, path+"/dynos", &dynos); err != nil {
+				return err
+			}
+
+			up := 0
+			for _, d := range dynos {
+				if d.Type != dynoFormation {
+					continue
+				}
+
+				if d.State != "up" {
+					return fmt.Errorf("dyno %q is %s", d.Name, d.State)
+				}
+				up++
+			}
+
+			if up != formation.Quantity {
+				return fmt.Errorf("%d of %d %s dynos up", up, formation.Quantity, dynoFormation)
+			}
+
+			return nil
+		},
+	}
+}
+
+// herokuGet calls the Heroku Platform API at path and decodes the response into v.
+func herokuGet(ctx context.Context, apiToken, path string, v any) error {
+	req, err := newRequest(ctx, http.MethodGet, herokuAPI+path, nil, map[string]string{
+		"Authorization": "Bearer " + apiToken,
+		"Accept":        "application/vnd.heroku+json; version=3",
+	})
+	if err != nil {
+		return err
+	}
+
+	return getJSON(req, v)
+}
@@ -0,0 +1,51 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewHerokuCheck(t *testing.T) {
+	tests := map[string]struct {
+		quantity, dynos string
+		wantErr         bool
+	}{
+		"every dyno up": {
+			quantity: `{"quantity":2}`,
+			dynos:    `[{"name":"web.1","type":"web","state":"up"},{"name":"web.2","type":"web","state":"up"},{"name":"worker.1","type":"worker","state":"crashed"}]`,
+		},
+		"dyno crashed": {
+			quantity: `{"quantity":2}`,
+			dynos:    `[{"name":"web.1","type":"web","state":"up"},{"name":"web.2","type":"web","state":"crashed"}]`,
+			wantErr:  true,
+		},
+		"dyno missing": {
+			quantity: `{"quantity":2}`,
+			dynos:    `[{"name":"web.1","type":"web","state":"up"}]`,
+			wantErr:  true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &herokuAPI, func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Accept"); got != "application/vnd.heroku+json; version=3" {
+					t.Errorf("unexpected accept header %q", got)
+				}
+
+				routes(map[string]http.HandlerFunc{
+					"/apps/my-app/formation/web": respondJSON(http.StatusOK, tt.quantity),
+					"/apps/my-app/dynos":         respondJSON(http.StatusOK, tt.dynos),
+				})(w, r)
+			})
+
+			assertCheck(t, NewHerokuCheck("heroku", "my-app", "web", "token").Check, tt.wantErr)
+		})
+	}
+
+	t.Run("unknown app", func(t *testing.T) {
+		mockAPI(t, &herokuAPI, respondJSON(http.StatusNotFound, `{"id":"not_found"}`))
+
+		assertCheck(t, NewHerokuCheck("heroku", "my-app", "web", "token").Check, true)
+	})
+}
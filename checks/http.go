@@ -0,0 +1,60 @@
+// Package checks provides ready-made health checks for common dependencies.
+package checks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// newRequest creates a request bound to ctx with the given headers set.
+func newRequest(ctx context.Context, method, url string, body io.Reader, header map[string]string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, fmt.Errorf("could not create request: %w", err)
+	}
+
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+
+	return req, nil
+}
+
+// expectStatus executes req and fails unless the response status is one of expected.
+func expectStatus(req *http.Request, expected ...int) error {
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	for _, code := range expected {
+		if res.StatusCode == code {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unexpected status code %d", res.StatusCode)
+}
+
+// getJSON executes req, expects a 200 response and decodes its body into v.
+func getJSON(req *http.Request, v any) error {
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return fmt.Errorf("could not decode response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package checks
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// mockAPI starts a server handling requests with handler and points api at it for the duration of the test.
+func mockAPI(t *testing.T, api *string, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	if api != nil {
+		original := *api
+		*api = srv.URL
+		t.Cleanup(func() { *api = original })
+	}
+
+	return srv
+}
+
+// respondJSON returns a handler responding with status and body as JSON.
+func respondJSON(status int, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+// routes returns a handler responding to each request URI with the handler registered for it, 404 otherwise.
+func routes(handlers map[string]http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h, ok := handlers[r.URL.RequestURI()]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		h(w, r)
+	}
+}
+
+// assertCheck fails the test unless the outcome of fn matches wantErr.
+func assertCheck(t *testing.T, fn func(context.Context) error, wantErr bool) {
+	t.Helper()
+
+	err := fn(context.Background())
+	if wantErr && err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if !wantErr && err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
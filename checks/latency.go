@@ -0,0 +1,52 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// CheckHTTPLatency creates a check which issues samples sequential GET requests to url and reports a degradation
+// when their 95th percentile latency exceeds p95Budget. It fails if any request fails or gets a server error.
+// The context bounds the total time of all requests.
+func CheckHTTPLatency(url string, samples int, p95Budget time.Duration) health.CheckFunc {
+	return func(ctx context.Context) error {
+		if samples < 1 {
+			samples = 1
+		}
+
+		latencies := make([]time.Duration, 0, samples)
+		for i := 0; i < samples; i++ {
+			req, err := newRequest(ctx, http.MethodGet, url, nil, nil)
+			if err != nil {
+				return err
+			}
+
+			start := time.Now()
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return fmt.Errorf("request failed: %w", err)
+			}
+			res.Body.Close()
+			latencies = append(latencies, time.Since(start))
+
+			if res.StatusCode >= http.StatusInternalServerError {
+				return fmt.Errorf("unexpected status code %d", res.StatusCode)
+			}
+		}
+
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		// nearest-rank percentile
+		p95 := latencies[(len(latencies)*95+99)/100-1]
+
+		if p95 > p95Budget {
+			return fmt.Errorf("%w: p95 latency %s exceeds budget %s", health.ErrDegraded, p95, p95Budget)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,55 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pcordeiro/go-health"
+)
+
+func TestCheckHTTPLatency(t *testing.T) {
+	t.Run("within budget", func(t *testing.T) {
+		var requests atomic.Int32
+		srv := mockAPI(t, nil, func(w http.ResponseWriter, r *http.Request) { requests.Add(1) })
+
+		assertCheck(t, CheckHTTPLatency(srv.URL, 5, time.Second), false)
+
+		if requests.Load() != 5 {
+			t.Errorf("expected 5 samples, got %d", requests.Load())
+		}
+	})
+
+	t.Run("over budget", func(t *testing.T) {
+		var requests atomic.Int32
+		srv := mockAPI(t, nil, func(w http.ResponseWriter, r *http.Request) {
+			// one slow request out of 20 stays within the p95
+			if requests.Add(1) == 1 {
+				time.Sleep(50 * time.Millisecond)
+			}
+		})
+
+		if err := CheckHTTPLatency(srv.URL, 20, 20*time.Millisecond)(context.Background()); err != nil {
+			t.Errorf("expected a single slow sample to be ignored, got %v", err)
+		}
+
+		srv = mockAPI(t, nil, func(w http.ResponseWriter, r *http.Request) { time.Sleep(20 * time.Millisecond) })
+
+		err := CheckHTTPLatency(srv.URL, 3, time.Millisecond)(context.Background())
+		if !errors.Is(err, health.ErrDegraded) {
+			t.Errorf("expected a degradation, got %v", err)
+		}
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		srv := mockAPI(t, nil, func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusInternalServerError) })
+
+		err := CheckHTTPLatency(srv.URL, 3, time.Second)(context.Background())
+		if err == nil || errors.Is(err, health.ErrDegraded) {
+			t.Errorf("expected a failure, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,43 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// sdkPollInterval is how often the feature flag SDK checks poll the readiness of their client.
+var sdkPollInterval = 50 * time.Millisecond
+
+// LaunchDarklyClient is the part of *ldclient.LDClient used by NewLaunchDarklyCheck.
+type LaunchDarklyClient interface {
+	Initialized() bool
+}
+
+// NewLaunchDarklyCheck creates a check which fails unless the LaunchDarkly SDK has connected within the check timeout.
+func NewLaunchDarklyCheck(name string, client LaunchDarklyClient) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			return waitReady(ctx, client.Initialized, errors.New("LaunchDarkly SDK is not initialized"))
+		},
+	}
+}
+
+// waitReady polls ready until it reports true, returning notReady if ctx is done first.
+func waitReady(ctx context.Context, ready func() bool, notReady error) error {
+	t := time.NewTicker(sdkPollInterval)
+	defer t.Stop()
+
+	for !ready() {
+		select {
+		case <-ctx.Done():
+			return notReady
+		case <-t.C:
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package checks
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeLaunchDarklyClient reports being initialized once initialized is set.
+type fakeLaunchDarklyClient struct {
+	initialized atomic.Bool
+}
+
+func (c *fakeLaunchDarklyClient) Initialized() bool {
+	return c.initialized.Load()
+}
+
+func TestNewLaunchDarklyCheck(t *testing.T) {
+	original := sdkPollInterval
+	sdkPollInterval = time.Millisecond
+	t.Cleanup(func() { sdkPollInterval = original })
+
+	t.Run("initialized", func(t *testing.T) {
+		client := &fakeLaunchDarklyClient{}
+		client.initialized.Store(true)
+
+		assertCheck(t, NewLaunchDarklyCheck("launchdarkly", client).Check, false)
+	})
+
+	t.Run("initialized while waiting", func(t *testing.T) {
+		client := &fakeLaunchDarklyClient{}
+		time.AfterFunc(10*time.Millisecond, func() { client.initialized.Store(true) })
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		if err := NewLaunchDarklyCheck("launchdarkly", client).Check(ctx); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("not initialized before the timeout", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		if err := NewLaunchDarklyCheck("launchdarkly", &fakeLaunchDarklyClient{}).Check(ctx); err == nil {
+			t.Error("expected an error, got nil")
+		}
+	})
+}
@@ -0,0 +1,20 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// CheckLibrary creates a check which calls probe, a function calling into a native dependency, so a missing or
+// incompatible shared library fails the check.
+func CheckLibrary(probe func() error) health.CheckFunc {
+	return func(ctx context.Context) error {
+		if err := probe(); err != nil {
+			return fmt.Errorf("native library probe failed: %w", err)
+		}
+
+		return nil
+	}
+}
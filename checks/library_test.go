@@ -0,0 +1,16 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestCheckLibrary(t *testing.T) {
+	assertCheck(t, CheckLibrary(func() error { return nil }), false)
+
+	errMissing := errors.New("libvips.so.42: cannot open shared object file")
+	if err := CheckLibrary(func() error { return errMissing })(context.Background()); !errors.Is(err, errMissing) {
+		t.Errorf("expected the probe error to be wrapped, got %v", err)
+	}
+}
@@ -0,0 +1,53 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/pcordeiro/go-health"
+)
+
+var mailgunAPI = "https://api.mailgun.net"
+
+// NewMailgunCheck creates a check which calls the Mailgun domains API to verify the domain exists, is active
+// and all its sending DNS records are valid.
+func NewMailgunCheck(name, domain, apiKey string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			req, err := newRequest(ctx, http.MethodGet, mailgunAPI+"/v3/domains/"+url.PathEscape(domain), nil, nil)
+			if err != nil {
+				return err
+			}
+			req.SetBasicAuth("api", apiKey)
+
+			var res struct {
+				Domain struct {
+					State string `json:"state"`
+				} `json:"domain"`
+				SendingDNSRecords []struct {
+					RecordType string `json:"record_type"`
+					Name       string `json:"name"`
+					Valid      string `json:"valid"`
+				} `json:"sending_dns_records"`
+			}
+			if err := getJSON(req, &res); err != nil {
+				return err
+			}
+
+			if res.Domain.State != "active" {
+				return fmt.Errorf("domain %q is %s", domain, res.Domain.State)
+			}
+
+			for _, r := range res.SendingDNSRecords {
+				if r.Valid != "valid" {
+					return fmt.Errorf("%s record %q of domain %q is %s", r.RecordType, r.Name, domain, r.Valid)
+				}
+			}
+
+			return nil
+		},
+	}
+}
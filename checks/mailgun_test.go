@@ -0,0 +1,46 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewMailgunCheck(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		body    string
+		wantErr bool
+	}{
+		"active with valid records": {
+			status: http.StatusOK,
+			body:   `{"domain":{"state":"active"},"sending_dns_records":[{"record_type":"TXT","name":"mg.example.com","valid":"valid"}]}`,
+		},
+		"unverified": {
+			status:  http.StatusOK,
+			body:    `{"domain":{"state":"unverified"}}`,
+			wantErr: true,
+		},
+		"invalid record": {
+			status:  http.StatusOK,
+			body:    `{"domain":{"state":"active"},"sending_dns_records":[{"record_type":"TXT","name":"mg.example.com","valid":"unknown"}]}`,
+			wantErr: true,
+		},
+		"unknown domain": {status: http.StatusNotFound, body: `{"message":"Domain not found"}`, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &mailgunAPI, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v3/domains/mg.example.com" {
+					t.Errorf("unexpected path %q", r.URL.Path)
+				}
+				if user, pass, ok := r.BasicAuth(); !ok || user != "api" || pass != "key" {
+					t.Errorf("unexpected basic auth %q:%q", user, pass)
+				}
+				respondJSON(tt.status, tt.body)(w, r)
+			})
+
+			assertCheck(t, NewMailgunCheck("mailgun", "mg.example.com", "key").Check, tt.wantErr)
+		})
+	}
+}
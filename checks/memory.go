@@ -0,0 +1,45 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"runtime"
+	"runtime/debug"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// memoryLimit returns the soft memory limit, it is a variable so the provider can be replaced.
+var memoryLimit = func() int64 {
+	return debug.SetMemoryLimit(-1)
+}
+
+// heapInUse returns the heap bytes in use, it is a variable so the provider can be replaced.
+var heapInUse = func() uint64 {
+	var s runtime.MemStats
+	runtime.ReadMemStats(&s)
+
+	return s.HeapAlloc
+}
+
+// CheckMemoryLimit creates a check which reports a degradation once the heap in use reaches warnRatio of the soft
+// memory limit set by GOMEMLIMIT or debug.SetMemoryLimit. It passes if no limit is set, as the heap then has no
+// limit to run into.
+func CheckMemoryLimit(warnRatio float64) health.CheckFunc {
+	return func(ctx context.Context) error {
+		limit := memoryLimit()
+		if limit <= 0 || limit == math.MaxInt64 {
+			return nil
+		}
+
+		used := heapInUse()
+		ratio := float64(used) / float64(limit)
+
+		if ratio >= warnRatio {
+			return fmt.Errorf("%w: heap uses %d of %d bytes memory limit (%.0f%%)", health.ErrDegraded, used, limit, ratio*100)
+		}
+
+		return nil
+	}
+}
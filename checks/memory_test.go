@@ -0,0 +1,42 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/pcordeiro/go-health"
+)
+
+func TestCheckMemoryLimit(t *testing.T) {
+	tests := map[string]struct {
+		limit        int64
+		used         uint64
+		wantErr      bool
+		wantDegraded bool
+	}{
+		"no limit":        {limit: math.MaxInt64, used: 1 << 40},
+		"below the ratio": {limit: 1000, used: 799},
+		"at the ratio":    {limit: 1000, used: 800, wantErr: true, wantDegraded: true},
+		"over the limit":  {limit: 1000, used: 1200, wantErr: true, wantDegraded: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			originalLimit, originalHeap := memoryLimit, heapInUse
+			t.Cleanup(func() { memoryLimit, heapInUse = originalLimit, originalHeap })
+			memoryLimit = func() int64 { return tt.limit }
+			heapInUse = func() uint64 { return tt.used }
+
+			err := CheckMemoryLimit(0.8)(context.Background())
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v, got %v", tt.wantErr, err)
+			}
+			if errors.Is(err, health.ErrDegraded) != tt.wantDegraded {
+				t.Errorf("expected a degradation: %v, got %v", tt.wantDegraded, err)
+			}
+		})
+	}
+}
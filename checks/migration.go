@@ -0,0 +1,28 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// CheckMigrationVersion creates a check which fails unless the schema version returned by currentFn is the one
+// expected by the binary, detecting a rolled back or ahead schema.
+func CheckMigrationVersion(currentFn func(ctx context.Context) (int, error), expected int) health.CheckFunc {
+	return func(ctx context.Context) error {
+		current, err := currentFn(ctx)
+		if err != nil {
+			return fmt.Errorf("could not get schema version: %w", err)
+		}
+
+		switch {
+		case current < expected:
+			return fmt.Errorf("schema version %d is behind the expected version %d", current, expected)
+		case current > expected:
+			return fmt.Errorf("schema version %d is ahead of the expected version %d", current, expected)
+		}
+
+		return nil
+	}
+}
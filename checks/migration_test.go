@@ -0,0 +1,28 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestCheckMigrationVersion(t *testing.T) {
+	tests := map[string]struct {
+		current int
+		err     error
+		wantErr bool
+	}{
+		"expected version": {current: 42},
+		"behind":           {current: 41, wantErr: true},
+		"ahead":            {current: 43, wantErr: true},
+		"query failure":    {err: errors.New("relation \"schema_migrations\" does not exist"), wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			current := func(context.Context) (int, error) { return tt.current, tt.err }
+
+			assertCheck(t, CheckMigrationVersion(current, 42), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package checks
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pcordeiro/go-health"
+)
+
+var mixpanelAPI = "https://api.mixpanel.com"
+
+// NewMixpanelCheck creates a check which sends a test event to the Mixpanel track endpoint and verifies
+// the response status is 1.
+func NewMixpanelCheck(name, token string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			event, err := json.Marshal(map[string]any{
+				"event": "go-health check",
+				"properties": map[string]string{
+					"token":       token,
+					"distinct_id": "go-health",
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("could not encode event: %w", err)
+			}
+
+			form := url.Values{"data": {base64.StdEncoding.EncodeToString(event)}}
+
+			req, err := newRequest(ctx, http.MethodPost, mixpanelAPI+"/track?verbose=1", strings.NewReader(form.Encode()), map[string]string{
+				"Content-Type": "application/x-www-form-urlencoded",
+			})
+			if err != nil {
+				return err
+			}
+
+			var res struct {
+				Status int    `json:"status"`
+				Error  string `json:"error"`
+			}
+			if err := getJSON(req, &res); err != nil {
+				return err
+			}
+
+			if res.Status != 1 {
+				return fmt.Errorf("event was rejected: %s", res.Error)
+			}
+
+			return nil
+		},
+	}
+}
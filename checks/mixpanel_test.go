@@ -0,0 +1,48 @@
+package checks
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestNewMixpanelCheck(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		body    string
+		wantErr bool
+	}{
+		"accepted":      {status: http.StatusOK, body: `{"status":1,"error":null}`},
+		"invalid token": {status: http.StatusOK, body: `{"status":0,"error":"token, missing or empty"}`, wantErr: true},
+		"outage":        {status: http.StatusServiceUnavailable, body: `{}`, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &mixpanelAPI, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/track" || r.URL.Query().Get("verbose") != "1" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL)
+				}
+
+				data, err := base64.StdEncoding.DecodeString(r.PostFormValue("data"))
+				if err != nil {
+					t.Errorf("invalid event encoding: %v", err)
+				}
+
+				var event struct {
+					Properties struct {
+						Token string `json:"token"`
+					} `json:"properties"`
+				}
+				if err := json.Unmarshal(data, &event); err != nil || event.Properties.Token != "token" {
+					t.Errorf("unexpected event %s: %v", data, err)
+				}
+
+				respondJSON(tt.status, tt.body)(w, r)
+			})
+
+			assertCheck(t, NewMixpanelCheck("mixpanel", "token").Check, tt.wantErr)
+		})
+	}
+}
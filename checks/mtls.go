@@ -0,0 +1,56 @@
+package checks
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// mtlsAlertWait is how long CheckMTLS waits for the server to reject the client certificate after the handshake.
+var mtlsAlertWait = 100 * time.Millisecond
+
+// CheckMTLS creates a check which performs a mutual TLS handshake with addr, failing if the server certificate
+// is not trusted by caPool or the server rejects the client certificate.
+func CheckMTLS(addr string, cert tls.Certificate, caPool *x509.CertPool) health.CheckFunc {
+	return func(ctx context.Context) error {
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			return fmt.Errorf("invalid address %q: %w", addr, err)
+		}
+
+		d := tls.Dialer{Config: &tls.Config{
+			ServerName:   host,
+			Certificates: []tls.Certificate{cert},
+			RootCAs:      caPool,
+		}}
+
+		conn, err := d.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return fmt.Errorf("mTLS handshake with %s failed: %w", addr, err)
+		}
+		defer conn.Close()
+
+		// With TLS 1.3 the server verifies the client certificate after the client considers the handshake
+		// complete, so a rejection only shows up as an alert on the first read.
+		deadline := time.Now().Add(mtlsAlertWait)
+		if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+			deadline = d
+		}
+		_ = conn.SetReadDeadline(deadline)
+
+		_, err = conn.Read(make([]byte, 1))
+
+		var netErr net.Error
+		if err == nil || errors.As(err, &netErr) && netErr.Timeout() {
+			return nil
+		}
+
+		return fmt.Errorf("%s rejected the client certificate: %w", addr, err)
+	}
+}
@@ -0,0 +1,123 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/pcordeiro/go-health"
+)
+
+var neonAPI = "https://console.neon.tech/api/v2"
+
+type neonEndpoint struct {
+	ID           string `json:"id"`
+	BranchID     string `json:"branch_id"`
+	Type         string `json:"type"`
+	CurrentState string `json:"current_state"`
+}
+
+// NewNeonCheck creates a check which calls the Neon API to verify the endpoint of the project's
+// primary branch is active.
+func NewNeonCheck(name, projectID, apiKey string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			var branches struct {
+				Branches []struct {
+					ID      string `json:"id"`
+					Default bool   `json:"default"`
+					Primary bool   `json:"primary"`
+				} `json:"branches"`
+			}
+			if err := neonGet(ctx, apiKey, "/projects/"+url.PathEscape(projectID)+"/branches", &branches); err != nil {
+				return err
+			}
+
+			branchID := ""
+			for _, b := range branches.Branches {
+				if b.Default || b.Primary {
+					branchID = b.ID
+					break
+				}
+			}
+
+			if branchID == "" {
+				return fmt.Errorf("project %q has no primary branch", projectID)
+			}
+
+			var endpoints struct {
+				Endpoints []neonEndpoint `json:"endpoints"`
+			}
+			if err := neonGet(ctx, apiKey, "/projects/"+url.PathEscape(projectID)+"/endpoints", &endpoints); err != nil {
+				return err
+			}
+
+			return neonEndpointActive(branchID, endpoints.Endpoints)
+		},
+	}
+}
+
+// neonGet calls the Neon API at path and decodes the response into v.
+func neonGet(ctx context.Context, apiKey, path string, v any) error {
+	req, err := newRequest(ctx, http.MethodGet, neonAPI+path, nil, map[string]string{
+		"Authorization": "Bearer " + apiKey,
+		"Accept":        "application/json",
+	})
+	if err != nil {
+		return err
+	}
+
+	return getJSON(req, v)
+}
+
+// neonEndpointActive fails unless the read-write endpoint of the branch is active.
+func neonEndpointActive(branchID string, endpoints []neonEndpoint) error {
+	for _, e := range endpoints {
+		if e.BranchID != branchID || e.Type != "read_write" {
+			continue
+		}
+
+		if e.CurrentState != "active" {
+			return fmt.Errorf("endpoint %q of branch %q is %s", e.ID, branchID, e.CurrentState)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("branch %q has no read-write endpoint", branchID)
+}
+
+// NewNeonBranchCheck creates a check which calls the Neon API to verify the branch is ready and its read-write
+// endpoint is active.
+func NewNeonBranchCheck(name, projectID, branchID, apiKey string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			path := "/projects/" + url.PathEscape(projectID) + "/branches/" + url.PathEscape(branchID)
+
+			var branch struct {
+				Branch struct {
+					CurrentState string `json:"current_state"`
+				} `json:"branch"`
+			}
+			if err := neonGet(ctx, apiKey, path, &branch); err != nil {
+				return err
+			}
+
+			if branch.Branch.CurrentState != "ready" {
+				return fmt.Errorf("branch %q is %s", branchID, branch.Branch.CurrentState)
+			}
+
+			var endpoints struct {
+				Endpoints []neonEndpoint `json:"endpoints"`
+			}
+			if err := neonGet(ctx, apiKey, path+"/endpoints", &endpoints); err != nil {
+				return err
+			}
+
+			return neonEndpointActive(branchID, endpoints.Endpoints)
+		},
+	}
+}
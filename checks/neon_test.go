@@ -0,0 +1,99 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewNeonCheck(t *testing.T) {
+	const branches = `{"branches":[{"id":"br-dev"},{"id":"br-main","default":true}]}`
+
+	tests := map[string]struct {
+		branches, endpoints string
+		wantErr             bool
+	}{
+		"active": {
+			branches:  branches,
+			endpoints: `{"endpoints":[{"id":"ep-1","branch_id":"br-main","type":"read_write","current_state":"active"}]}`,
+		},
+		"idle": {
+			branches:  branches,
+			endpoints: `{"endpoints":[{"id":"ep-1","branch_id":"br-main","type":"read_write","current_state":"idle"}]}`,
+			wantErr:   true,
+		},
+		"no read-write endpoint": {
+			branches:  branches,
+			endpoints: `{"endpoints":[{"id":"ep-1","branch_id":"br-main","type":"read_only","current_state":"active"}]}`,
+			wantErr:   true,
+		},
+		"no primary branch": {
+			branches:  `{"branches":[{"id":"br-dev"}]}`,
+			endpoints: `{"endpoints":[]}`,
+			wantErr:   true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &neonAPI, func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Authorization"); got != "Bearer key" {
+					t.Errorf("unexpected authorization %q", got)
+				}
+
+				routes(map[string]http.HandlerFunc{
+					"/projects/p1/branches":  respondJSON(http.StatusOK, tt.branches),
+					"/projects/p1/endpoints": respondJSON(http.StatusOK, tt.endpoints),
+				})(w, r)
+			})
+
+			assertCheck(t, NewNeonCheck("neon", "p1", "key").Check, tt.wantErr)
+		})
+	}
+
+	t.Run("api error", func(t *testing.T) {
+		mockAPI(t, &neonAPI, respondJSON(http.StatusInternalServerError, `{}`))
+
+		assertCheck(t, NewNeonCheck("neon", "p1", "key").Check, true)
+	})
+}
+
+func TestNewNeonBranchCheck(t *testing.T) {
+	const active = `{"endpoints":[{"id":"ep-1","branch_id":"br-feature","type":"read_write","current_state":"active"}]}`
+
+	tests := map[string]struct {
+		branch, endpoints string
+		wantErr           bool
+	}{
+		"ready and active": {
+			branch:    `{"branch":{"current_state":"ready"}}`,
+			endpoints: active,
+		},
+		"branch initializing": {
+			branch:    `{"branch":{"current_state":"init"}}`,
+			endpoints: active,
+			wantErr:   true,
+		},
+		"endpoint suspended": {
+			branch:    `{"branch":{"current_state":"ready"}}`,
+			endpoints: `{"endpoints":[{"id":"ep-1","branch_id":"br-feature","type":"read_write","current_state":"suspended"}]}`,
+			wantErr:   true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &neonAPI, routes(map[string]http.HandlerFunc{
+				"/projects/p1/branches/br-feature":           respondJSON(http.StatusOK, tt.branch),
+				"/projects/p1/branches/br-feature/endpoints": respondJSON(http.StatusOK, tt.endpoints),
+			}))
+
+			assertCheck(t, NewNeonBranchCheck("neon", "p1", "br-feature", "key").Check, tt.wantErr)
+		})
+	}
+
+	t.Run("unknown branch", func(t *testing.T) {
+		mockAPI(t, &neonAPI, respondJSON(http.StatusNotFound, `{"message":"not found"}`))
+
+		assertCheck(t, NewNeonBranchCheck("neon", "p1", "br-feature", "key").Check, true)
+	})
+}
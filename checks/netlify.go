@@ -0,0 +1,47 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/pcordeiro/go-health"
+)
+
+var netlifyAPI = "https://api.netlify.com/api/v1"
+
+// NewNetlifyCheck creates a check which calls the Netlify API to verify the latest production deploy of the site
+// is ready.
+func NewNetlifyCheck(name, siteID, apiToken string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			req, err := newRequest(ctx, http.MethodGet, netlifyAPI+"/sites/"+url.PathEscape(siteID)+"/deploys?production=true&per_page=1", nil, map[string]string{
+				"Authorization": "Bearer " + apiToken,
+			})
+			if err != nil {
+				return err
+			}
+
+			var deploys []struct {
+				ID    string `json:"id"`
+				State string `json:"state"`
+			}
+			if err := getJSON(req, &deploys); err != nil {
+				return err
+			}
+
+			if len(deploys) == 0 {
+				return errors.New("site has no production deploy")
+			}
+
+			if d := deploys[0]; d.State != "ready" {
+				return fmt.Errorf("production deploy %q is %s", d.ID, d.State)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewNetlifyCheck(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		body    string
+		wantErr bool
+	}{
+		"ready":        {status: http.StatusOK, body: `[{"id":"d1","state":"ready"}]`},
+		"errored":      {status: http.StatusOK, body: `[{"id":"d1","state":"error"}]`, wantErr: true},
+		"no deploy":    {status: http.StatusOK, body: `[]`, wantErr: true},
+		"unauthorized": {status: http.StatusUnauthorized, body: `{"code":401}`, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &netlifyAPI, func(w http.ResponseWriter, r *http.Request) {
+				q := r.URL.Query()
+				if r.URL.Path != "/sites/site-1/deploys" || q.Get("production") != "true" || q.Get("per_page") != "1" {
+					t.Errorf("unexpected request %s", r.URL)
+				}
+				if got := r.Header.Get("Authorization"); got != "Bearer token" {
+					t.Errorf("unexpected authorization %q", got)
+				}
+				respondJSON(tt.status, tt.body)(w, r)
+			})
+
+			assertCheck(t, NewNetlifyCheck("netlify", "site-1", "token").Check, tt.wantErr)
+		})
+	}
+}
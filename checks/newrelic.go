@@ -0,0 +1,42 @@
+package checks
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/pcordeiro/go-health"
+)
+
+var (
+	newRelicCollector = "https://collector.newrelic.com"
+	newRelicMetricAPI = "https://metric-api.newrelic.com"
+)
+
+// NewNewRelicCheck creates a check which verifies the New Relic collector is up, through its /status/mongrel
+// endpoint, and that the ingest endpoint accepts the license key by sending it an empty metric batch.
+func NewNewRelicCheck(name, licenseKey string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			req, err := newRequest(ctx, http.MethodGet, newRelicCollector+"/status/mongrel", nil, nil)
+			if err != nil {
+				return err
+			}
+
+			if err := expectStatus(req, http.StatusOK); err != nil {
+				return err
+			}
+
+			req, err = newRequest(ctx, http.MethodPost, newRelicMetricAPI+"/metric/v1", strings.NewReader("[]"), map[string]string{
+				"Api-Key":      licenseKey,
+				"Content-Type": "application/json",
+			})
+			if err != nil {
+				return err
+			}
+
+			return expectStatus(req, http.StatusOK, http.StatusAccepted)
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package checks
+
+import (
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestNewNewRelicCheck(t *testing.T) {
+	tests := map[string]struct {
+		collectorStatus, ingestStatus int
+		wantErr                       bool
+	}{
+		"connected":       {collectorStatus: http.StatusOK, ingestStatus: http.StatusAccepted},
+		"collector down":  {collectorStatus: http.StatusServiceUnavailable, ingestStatus: http.StatusAccepted, wantErr: true},
+		"invalid license": {collectorStatus: http.StatusOK, ingestStatus: http.StatusForbidden, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &newRelicCollector, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/status/mongrel" {
+					t.Errorf("unexpected collector path %q", r.URL.Path)
+				}
+				w.WriteHeader(tt.collectorStatus)
+			})
+
+			mockAPI(t, &newRelicMetricAPI, func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if r.Method != http.MethodPost || r.URL.Path != "/metric/v1" || string(body) != "[]" {
+					t.Errorf("unexpected ingest request %s %s %q", r.Method, r.URL.Path, body)
+				}
+				if got := r.Header.Get("Api-Key"); got != "license" {
+					t.Errorf("unexpected api key %q", got)
+				}
+				w.WriteHeader(tt.ingestStatus)
+			})
+
+			assertCheck(t, NewNewRelicCheck("newrelic", "license").Check, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package checks
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// ocspRootCAs verifies the certificates served to CheckOCSP, nil meaning the system pool. It is a variable so
+// the roots can be replaced.
+var ocspRootCAs *x509.CertPool
+
+// CheckOCSP creates a check which fetches the leaf certificate served at certURL and queries its OCSP responder,
+// failing if the certificate is revoked or unknown to the responder.
+func CheckOCSP(certURL string) health.CheckFunc {
+	return func(ctx context.Context) error {
+		u, err := url.Parse(certURL)
+		if err != nil {
+			return fmt.Errorf("invalid url %q: %w", certURL, err)
+		}
+
+		addr := u.Host
+		if u.Port() == "" {
+			addr = net.JoinHostPort(u.Hostname(), "443")
+		}
+
+		d := tls.Dialer{Config: &tls.Config{ServerName: u.Hostname(), RootCAs: ocspRootCAs}}
+		conn, err := d.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return fmt.Errorf("could not connect to %s: %w", addr, err)
+		}
+		chains := conn.(*tls.Conn).ConnectionState().VerifiedChains
+		conn.Close()
+
+		if len(chains) == 0 || len(chains[0]) < 2 {
+			return errors.New("could not find the issuer of the certificate")
+		}
+		leaf, issuer := chains[0][0], chains[0][1]
+
+		if len(leaf.OCSPServer) == 0 {
+			return errors.New("certificate has no OCSP responder")
+		}
+
+		res, err := queryOCSP(ctx, leaf.OCSPServer[0], leaf, issuer)
+		if err != nil {
+			return err
+		}
+
+		switch res.Status {
+		case ocsp.Good:
+			return nil
+		case ocsp.Revoked:
+			return fmt.Errorf("certificate was revoked at %s", res.RevokedAt)
+		default:
+			return errors.New("certificate status is unknown to the OCSP responder")
+		}
+	}
+}
+
+// queryOCSP asks the responder for the revocation status of the certificate.
+func queryOCSP(ctx context.Context, responder string, leaf, issuer *x509.Certificate) (*ocsp.Response, error) {
+	body, err := ocsp.CreateRequest(leaf, issuer, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create OCSP request: %w", err)
+	}
+
+	req, err := newRequest(ctx, http.MethodPost, responder, bytes.NewReader(body), map[string]string{
+		"Content-Type": "application/ocsp-request",
+		"Accept":       "application/ocsp-response",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("OCSP request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OCSP responder returned status code %d", res.StatusCode)
+	}
+
+	raw, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not read OCSP response: %w", err)
+	}
+
+	r, err := ocsp.ParseResponseForCert(raw, leaf, issuer)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OCSP response: %w", err)
+	}
+
+	return r, nil
+}
@@ -0,0 +1,121 @@
+package checks
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+)
+
+func TestCheckOCSP(t *testing.T) {
+	tests := map[string]struct {
+		status         int
+		responseStatus int
+		wantErr        bool
+	}{
+		"good":              {status: http.StatusOK, responseStatus: ocsp.Good},
+		"revoked":           {status: http.StatusOK, responseStatus: ocsp.Revoked, wantErr: true},
+		"unknown":           {status: http.StatusOK, responseStatus: ocsp.Unknown, wantErr: true},
+		"responder failing": {status: http.StatusInternalServerError, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			caKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			caTemplate := &x509.Certificate{
+				SerialNumber:          big.NewInt(1),
+				Subject:               pkix.Name{CommonName: "test CA"},
+				NotBefore:             time.Now().Add(-time.Hour),
+				NotAfter:              time.Now().Add(time.Hour),
+				IsCA:                  true,
+				BasicConstraintsValid: true,
+				KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+			}
+			ca := createCertificate(t, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+
+			var leaf *x509.Certificate
+
+			responder := mockAPI(t, nil, func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if _, err := ocsp.ParseRequest(body); err != nil {
+					t.Errorf("invalid OCSP request: %v", err)
+				}
+
+				if tt.status != http.StatusOK {
+					w.WriteHeader(tt.status)
+					return
+				}
+
+				res, err := ocsp.CreateResponse(ca, ca, ocsp.Response{
+					Status:       tt.responseStatus,
+					SerialNumber: leaf.SerialNumber,
+					ThisUpdate:   time.Now().Add(-time.Minute),
+					NextUpdate:   time.Now().Add(time.Hour),
+					RevokedAt:    time.Now().Add(-time.Minute),
+				}, caKey)
+				if err != nil {
+					t.Errorf("could not create OCSP response: %v", err)
+				}
+
+				_, _ = w.Write(res)
+			})
+
+			leafKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			leaf = createCertificate(t, &x509.Certificate{
+				SerialNumber: big.NewInt(2),
+				Subject:      pkix.Name{CommonName: "127.0.0.1"},
+				IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+				NotBefore:    time.Now().Add(-time.Hour),
+				NotAfter:     time.Now().Add(time.Hour),
+				KeyUsage:     x509.KeyUsageDigitalSignature,
+				ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+				OCSPServer:   []string{responder.URL},
+			}, ca, &leafKey.PublicKey, caKey)
+
+			srv := httptest.NewUnstartedServer(http.NotFoundHandler())
+			srv.TLS = &tls.Config{Certificates: []tls.Certificate{{
+				Certificate: [][]byte{leaf.Raw, ca.Raw},
+				PrivateKey:  leafKey,
+			}}}
+			srv.StartTLS()
+			t.Cleanup(srv.Close)
+
+			pool := x509.NewCertPool()
+			pool.AddCert(ca)
+			original := ocspRootCAs
+			ocspRootCAs = pool
+			t.Cleanup(func() { ocspRootCAs = original })
+
+			assertCheck(t, CheckOCSP(srv.URL), tt.wantErr)
+		})
+	}
+}
+
+// createCertificate creates a certificate from template signed by parent with key.
+func createCertificate(t *testing.T, template, parent *x509.Certificate, pub any, key crypto.Signer) *x509.Certificate {
+	t.Helper()
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pub, key)
+	if err != nil {
+		t.Fatalf("could not create certificate: %v", err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("could not parse certificate: %v", err)
+	}
+
+	return cert
+}
@@ -0,0 +1,71 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/pcordeiro/go-health"
+)
+
+var pagerDutyAPI = "https://api.pagerduty.com"
+
+// NewPagerDutyCheck creates a check which calls the PagerDuty API to verify the service is not in maintenance
+// and has no open P1 incident.
+func NewPagerDutyCheck(name, serviceID, apiToken string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			var s struct {
+				Service struct {
+					Status string `json:"status"`
+				} `json:"service"`
+			}
+			if err := pagerDutyGet(ctx, apiToken, "/services/"+url.PathEscape(serviceID), &s); err != nil {
+				return err
+			}
+
+			if s.Service.Status == "maintenance" {
+				return fmt.Errorf("service %q is in maintenance", serviceID)
+			}
+
+			var incidents struct {
+				Incidents []struct {
+					IncidentNumber int `json:"incident_number"`
+					Priority       *struct {
+						Summary string `json:"summary"`
+					} `json:"priority"`
+				} `json:"incidents"`
+			}
+			q := url.Values{
+				"service_ids[]": {serviceID},
+				"statuses[]":    {"triggered", "acknowledged"},
+			}
+			if err := pagerDutyGet(ctx, apiToken, "/incidents?"+q.Encode(), &incidents); err != nil {
+				return err
+			}
+
+			for _, i := range incidents.Incidents {
+				if i.Priority != nil && i.Priority.Summary == "P1" {
+					return fmt.Errorf("service %q has open P1 incident #%d", serviceID, i.IncidentNumber)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// pagerDutyGet calls the PagerDuty API at path and decodes the response into v.
+func pagerDutyGet(ctx context.Context, apiToken, path string, v any) error {
+	req, err := newRequest(ctx, http.MethodGet, pagerDutyAPI+path, nil, map[string]string{
+		"Authorization": "Token token=" + apiToken,
+		"Accept":        "application/vnd.pagerduty+json;version=2",
+	})
+	if err != nil {
+		return err
+	}
+
+	return getJSON(req, v)
+}
@@ -0,0 +1,62 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewPagerDutyCheck(t *testing.T) {
+	tests := map[string]struct {
+		service, incidents string
+		wantErr            bool
+	}{
+		"active without incidents": {
+			service:   `{"service":{"status":"active"}}`,
+			incidents: `{"incidents":[]}`,
+		},
+		"open P2 incident": {
+			service:   `{"service":{"status":"warning"}}`,
+			incidents: `{"incidents":[{"incident_number":7,"priority":{"summary":"P2"}},{"incident_number":8}]}`,
+		},
+		"maintenance": {
+			service: `{"service":{"status":"maintenance"}}`,
+			wantErr: true,
+		},
+		"open P1 incident": {
+			service:   `{"service":{"status":"critical"}}`,
+			incidents: `{"incidents":[{"incident_number":9,"priority":{"summary":"P1"}}]}`,
+			wantErr:   true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &pagerDutyAPI, func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Authorization"); got != "Token token=secret" {
+					t.Errorf("unexpected authorization %q", got)
+				}
+
+				switch r.URL.Path {
+				case "/services/PSVC":
+					respondJSON(http.StatusOK, tt.service)(w, r)
+				case "/incidents":
+					q := r.URL.Query()
+					if q.Get("service_ids[]") != "PSVC" || len(q["statuses[]"]) != 2 {
+						t.Errorf("unexpected incidents query %q", r.URL.RawQuery)
+					}
+					respondJSON(http.StatusOK, tt.incidents)(w, r)
+				default:
+					http.NotFound(w, r)
+				}
+			})
+
+			assertCheck(t, NewPagerDutyCheck("pagerduty", "PSVC", "secret").Check, tt.wantErr)
+		})
+	}
+
+	t.Run("unauthorized", func(t *testing.T) {
+		mockAPI(t, &pagerDutyAPI, respondJSON(http.StatusUnauthorized, `{"error":{"message":"Unauthorized"}}`))
+
+		assertCheck(t, NewPagerDutyCheck("pagerduty", "PSVC", "secret").Check, true)
+	})
+}
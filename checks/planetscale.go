@@ -0,0 +1,67 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pcordeiro/go-health"
+)
+
+var planetScaleAPI = "https://api.planetscale.com/v1"
+
+// NewPlanetScaleCheck creates a check which calls the PlanetScale API to verify the branch is ready
+// and has no schema change being deployed to it.
+func NewPlanetScaleCheck(name, org, database, branch, serviceTokenID, serviceToken string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			header := map[string]string{
+				"Authorization": serviceTokenID + ":" + serviceToken,
+				"Accept":        "application/json",
+			}
+			base := fmt.Sprintf("%s/organizations/%s/databases/%s", planetScaleAPI, url.PathEscape(org), url.PathEscape(database))
+
+			req, err := newRequest(ctx, http.MethodGet, base+"/branches/"+url.PathEscape(branch), nil, header)
+			if err != nil {
+				return err
+			}
+
+			var b struct {
+				Ready bool `json:"ready"`
+			}
+			if err := getJSON(req, &b); err != nil {
+				return err
+			}
+
+			if !b.Ready {
+				return fmt.Errorf("branch %q is not ready", branch)
+			}
+
+			req, err = newRequest(ctx, http.MethodGet, base+"/deploy-requests?state=open&branch="+url.QueryEscape(branch), nil, header)
+			if err != nil {
+				return err
+			}
+
+			var drs struct {
+				Data []struct {
+					Number          int    `json:"number"`
+					DeploymentState string `json:"deployment_state"`
+				} `json:"data"`
+			}
+			if err := getJSON(req, &drs); err != nil {
+				return err
+			}
+
+			for _, dr := range drs.Data {
+				if strings.HasPrefix(dr.DeploymentState, "in_progress") || dr.DeploymentState == "pending_cutover" {
+					return fmt.Errorf("branch %q has a schema change in progress (deploy request #%d is %s)", branch, dr.Number, dr.DeploymentState)
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,61 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewPlanetScaleCheck(t *testing.T) {
+	const (
+		branchURI  = "/organizations/acme/databases/app/branches/main"
+		requestURI = "/organizations/acme/databases/app/deploy-requests?state=open&branch=main"
+	)
+
+	tests := map[string]struct {
+		branch, requests string
+		wantErr          bool
+	}{
+		"ready": {
+			branch:   `{"ready":true}`,
+			requests: `{"data":[{"number":1,"deployment_state":"ready"}]}`,
+		},
+		"not ready": {
+			branch:   `{"ready":false}`,
+			requests: `{"data":[]}`,
+			wantErr:  true,
+		},
+		"deploy in progress": {
+			branch:   `{"ready":true}`,
+			requests: `{"data":[{"number":2,"deployment_state":"in_progress_cutover"}]}`,
+			wantErr:  true,
+		},
+		"pending cutover": {
+			branch:   `{"ready":true}`,
+			requests: `{"data":[{"number":3,"deployment_state":"pending_cutover"}]}`,
+			wantErr:  true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &planetScaleAPI, func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Authorization"); got != "id:token" {
+					t.Errorf("unexpected authorization %q", got)
+				}
+
+				routes(map[string]http.HandlerFunc{
+					branchURI:  respondJSON(http.StatusOK, tt.branch),
+					requestURI: respondJSON(http.StatusOK, tt.requests),
+				})(w, r)
+			})
+
+			assertCheck(t, NewPlanetScaleCheck("planetscale", "acme", "app", "main", "id", "token").Check, tt.wantErr)
+		})
+	}
+
+	t.Run("api error", func(t *testing.T) {
+		mockAPI(t, &planetScaleAPI, respondJSON(http.StatusUnauthorized, `{}`))
+
+		assertCheck(t, NewPlanetScaleCheck("planetscale", "acme", "app", "main", "id", "token").Check, true)
+	})
+}
@@ -0,0 +1,24 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// CheckPortFree creates a check which binds addr and fails if it is already in use,
+// e.g. by a zombie process. The bind is released immediately on success.
+func CheckPortFree(addr string) health.CheckFunc {
+	return func(ctx context.Context) error {
+		var lc net.ListenConfig
+
+		l, err := lc.Listen(ctx, "tcp", addr)
+		if err != nil {
+			return fmt.Errorf("address %s is not free: %w", addr, err)
+		}
+
+		return l.Close()
+	}
+}
@@ -0,0 +1,30 @@
+package checks
+
+import (
+	"net"
+	"testing"
+)
+
+func TestCheckPortFree(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("could not listen: %v", err)
+	}
+	addr := l.Addr().String()
+
+	t.Run("in use", func(t *testing.T) {
+		assertCheck(t, CheckPortFree(addr), true)
+	})
+
+	if err := l.Close(); err != nil {
+		t.Fatalf("could not close listener: %v", err)
+	}
+
+	t.Run("free", func(t *testing.T) {
+		assertCheck(t, CheckPortFree(addr), false)
+	})
+
+	t.Run("released after the check", func(t *testing.T) {
+		assertCheck(t, CheckPortFree(addr), false)
+	})
+}
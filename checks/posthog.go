@@ -0,0 +1,37 @@
+package checks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// NewPostHogCheck creates a check which calls the PostHog /decide endpoint of host and expects a 200 response.
+func NewPostHogCheck(name, personalAPIKey, host string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			body, err := json.Marshal(map[string]string{
+				"api_key":     personalAPIKey,
+				"distinct_id": "go-health",
+			})
+			if err != nil {
+				return fmt.Errorf("could not encode request: %w", err)
+			}
+
+			req, err := newRequest(ctx, http.MethodPost, strings.TrimSuffix(host, "/")+"/decide/?v=3", bytes.NewReader(body), map[string]string{
+				"Content-Type": "application/json",
+			})
+			if err != nil {
+				return err
+			}
+
+			return expectStatus(req, http.StatusOK)
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package checks
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestNewPostHogCheck(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		wantErr bool
+	}{
+		"reachable":   {status: http.StatusOK},
+		"invalid key": {status: http.StatusUnauthorized, wantErr: true},
+		"outage":      {status: http.StatusInternalServerError, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := mockAPI(t, nil, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/decide/" || r.URL.Query().Get("v") != "3" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL)
+				}
+
+				var body map[string]string
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["api_key"] != "phx_key" {
+					t.Errorf("unexpected body %v: %v", body, err)
+				}
+
+				respondJSON(tt.status, `{}`)(w, r)
+			})
+
+			assertCheck(t, NewPostHogCheck("posthog", "phx_key", srv.URL+"/").Check, tt.wantErr)
+		})
+	}
+}
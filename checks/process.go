@@ -0,0 +1,19 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// CheckProcess creates a check which fails if the process with the given pid is not running.
+func CheckProcess(pid int) health.CheckFunc {
+	return func(ctx context.Context) error {
+		if err := processAlive(pid); err != nil {
+			return fmt.Errorf("process %d is not running: %w", pid, err)
+		}
+
+		return nil
+	}
+}
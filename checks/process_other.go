@@ -0,0 +1,15 @@
+//go:build !unix
+
+package checks
+
+import "os"
+
+// processAlive relies on os.FindProcess, which fails for missing processes on non-unix systems.
+func processAlive(pid int) error {
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return err
+	}
+
+	return p.Release()
+}
@@ -0,0 +1,10 @@
+package checks
+
+import (
+	"os"
+	"testing"
+)
+
+func TestCheckProcess(t *testing.T) {
+	assertCheck(t, CheckProcess(os.Getpid()), false)
+}
@@ -0,0 +1,24 @@
+//go:build unix
+
+package checks
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// processAlive sends signal 0 to the process, which performs the existence and permission checks without
+// delivering a signal. EPERM means the process exists but belongs to another user, so it is alive.
+func processAlive(pid int) error {
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return err
+	}
+
+	if err := p.Signal(syscall.Signal(0)); err != nil && !errors.Is(err, syscall.EPERM) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+//go:build unix
+
+package checks
+
+import (
+	"os/exec"
+	"testing"
+)
+
+func TestCheckProcessUnix(t *testing.T) {
+	t.Run("process of another user", func(t *testing.T) {
+		// init always runs, signaling it fails with EPERM unless the test runs as root
+		assertCheck(t, CheckProcess(1), false)
+	})
+
+	t.Run("exited process", func(t *testing.T) {
+		cmd := exec.Command("true")
+		if err := cmd.Run(); err != nil {
+			t.Skipf("could not run a child process: %v", err)
+		}
+
+		assertCheck(t, CheckProcess(cmd.Process.Pid), true)
+	})
+}
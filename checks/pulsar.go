@@ -0,0 +1,45 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// NewPulsarCheck creates a check which calls the Pulsar admin API broker health endpoint and expects "ok".
+func NewPulsarCheck(name, brokerURL string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			req, err := newRequest(ctx, http.MethodGet, strings.TrimSuffix(brokerURL, "/")+"/admin/v2/brokers/health", nil, nil)
+			if err != nil {
+				return err
+			}
+
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return fmt.Errorf("request failed: %w", err)
+			}
+			defer res.Body.Close()
+
+			if res.StatusCode != http.StatusOK {
+				return fmt.Errorf("unexpected status code %d", res.StatusCode)
+			}
+
+			body, err := io.ReadAll(io.LimitReader(res.Body, 1024))
+			if err != nil {
+				return fmt.Errorf("could not read response: %w", err)
+			}
+
+			if strings.TrimSpace(string(body)) != "ok" {
+				return fmt.Errorf("broker reported %q", body)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,33 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewPulsarCheck(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		body    string
+		wantErr bool
+	}{
+		"healthy":     {status: http.StatusOK, body: "ok\n"},
+		"unexpected":  {status: http.StatusOK, body: "degraded", wantErr: true},
+		"unavailable": {status: http.StatusServiceUnavailable, body: "ok", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := mockAPI(t, nil, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/admin/v2/brokers/health" {
+					t.Errorf("unexpected path %q", r.URL.Path)
+				}
+
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			})
+
+			assertCheck(t, NewPulsarCheck("pulsar", srv.URL+"/").Check, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// NewRabbitMQVHostCheck creates a check which calls the RabbitMQ management API to verify the vhost is running
+// on every cluster node and holds at least one queue. Queues without consumers are accepted, see
+// NewRabbitMQVHostCheckWithMinConsumers to require them.
+func NewRabbitMQVHostCheck(name, managementURL, vhost, user, password string) health.Check {
+	return NewRabbitMQVHostCheckWithMinConsumers(name, managementURL, vhost, user, password, 0)
+}
+
+// NewRabbitMQVHostCheckWithMinConsumers creates a check like NewRabbitMQVHostCheck which also requires the queues of
+// the vhost to have at least minConsumers consumers in total, e.g. 1 for a vhost whose workers must be connected.
+func NewRabbitMQVHostCheckWithMinConsumers(name, managementURL, vhost, user, password string, minConsumers int) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			base := strings.TrimSuffix(managementURL, "/")
+
+			var v struct {
+				ClusterState map[string]string `json:"cluster_state"`
+			}
+			if err := rabbitMQGet(ctx, base+"/api/vhosts/"+url.PathEscape(vhost), user, password, &v); err != nil {
+				return err
+			}
+
+			for node, state := range v.ClusterState {
+				if state != "running" {
+					return fmt.Errorf("vhost %q is %s on node %s", vhost, state, node)
+				}
+			}
+
+			var queues []struct {
+				Consumers int `json:"consumers"`
+			}
+			if err := rabbitMQGet(ctx, base+"/api/queues/"+url.PathEscape(vhost), user, password, &queues); err != nil {
+				return err
+			}
+
+			if len(queues) == 0 {
+				return fmt.Errorf("vhost %q has no queues", vhost)
+			}
+
+			consumers := 0
+			for _, q := range queues {
+				consumers += q.Consumers
+			}
+
+			if consumers < minConsumers {
+				return fmt.Errorf("vhost %q has %d consumers on %d queues, expected at least %d", vhost, consumers, len(queues), minConsumers)
+			}
+
+			return nil
+		},
+	}
+}
+
+// rabbitMQGet calls the management API at u and decodes the response into v.
+func rabbitMQGet(ctx context.Context, u, user, password string, v any) error {
+	req, err := newRequest(ctx, http.MethodGet, u, nil, map[string]string{"Accept": "application/json"})
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(user, password)
+
+	return getJSON(req, v)
+}
@@ -0,0 +1,48 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewRabbitMQVHostCheck(t *testing.T) {
+	const running = `{"cluster_state":{"rabbit@a":"running","rabbit@b":"running"}}`
+
+	tests := map[string]struct {
+		vhost, queues string
+		minConsumers  int
+		wantErr       bool
+	}{
+		"healthy":                {vhost: running, queues: `[{"consumers":0},{"consumers":2}]`},
+		"node stopped":           {vhost: `{"cluster_state":{"rabbit@a":"running","rabbit@b":"stopped"}}`, queues: `[{"consumers":1}]`, wantErr: true},
+		"no queues":              {vhost: running, queues: `[]`, wantErr: true},
+		"idle without consumers": {vhost: running, queues: `[{"consumers":0}]`},
+		"minimum consumers met":  {vhost: running, queues: `[{"consumers":1},{"consumers":1}]`, minConsumers: 2},
+		"too few consumers":      {vhost: running, queues: `[{"consumers":0},{"consumers":1}]`, minConsumers: 2, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := mockAPI(t, nil, func(w http.ResponseWriter, r *http.Request) {
+				if user, password, ok := r.BasicAuth(); !ok || user != "guest" || password != "secret" {
+					t.Errorf("unexpected credentials %q:%q", user, password)
+				}
+
+				routes(map[string]http.HandlerFunc{
+					"/api/vhosts/orders": respondJSON(http.StatusOK, tt.vhost),
+					"/api/queues/orders": respondJSON(http.StatusOK, tt.queues),
+				})(w, r)
+			})
+
+			check := NewRabbitMQVHostCheckWithMinConsumers("rabbitmq", srv.URL+"/", "orders", "guest", "secret", tt.minConsumers)
+
+			assertCheck(t, check.Check, tt.wantErr)
+		})
+	}
+
+	t.Run("unknown vhost", func(t *testing.T) {
+		srv := mockAPI(t, nil, respondJSON(http.StatusNotFound, `{"error":"Object Not Found"}`))
+
+		assertCheck(t, NewRabbitMQVHostCheck("rabbitmq", srv.URL, "orders", "guest", "secret").Check, true)
+	})
+}
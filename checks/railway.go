@@ -0,0 +1,79 @@
+package checks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/pcordeiro/go-health"
+)
+
+var railwayAPI = "https://backboard.railway.app/graphql/v2"
+
+const railwayDeploymentsQuery = `query($serviceId: String!) {
+  deployments(first: 1, input: {serviceId: $serviceId}) {
+    edges { node { id status } }
+  }
+}`
+
+// NewRailwayCheck creates a check which calls the Railway GraphQL API to verify the latest deployment of the
+// service is SUCCESS.
+func NewRailwayCheck(name, serviceID, apiToken string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			body, err := json.Marshal(map[string]any{
+				"query":     railwayDeploymentsQuery,
+				"variables": map[string]string{"serviceId": serviceID},
+			})
+			if err != nil {
+				return fmt.Errorf("could not encode query: %w", err)
+			}
+
+			req, err := newRequest(ctx, http.MethodPost, railwayAPI, bytes.NewReader(body), map[string]string{
+				"Authorization": "Bearer " + apiToken,
+				"Content-Type":  "application/json",
+			})
+			if err != nil {
+				return err
+			}
+
+			var res struct {
+				Data struct {
+					Deployments struct {
+						Edges []struct {
+							Node struct {
+								ID     string `json:"id"`
+								Status string `json:"status"`
+							} `json:"node"`
+						} `json:"edges"`
+					} `json:"deployments"`
+				} `json:"data"`
+				Errors []struct {
+					Message string `json:"message"`
+				} `json:"errors"`
+			}
+			if err := getJSON(req, &res); err != nil {
+				return err
+			}
+
+			if len(res.Errors) > 0 {
+				return fmt.Errorf("query failed: %s", res.Errors[0].Message)
+			}
+
+			edges := res.Data.Deployments.Edges
+			if len(edges) == 0 {
+				return errors.New("service has no deployment")
+			}
+
+			if d := edges[0].Node; d.Status != "SUCCESS" {
+				return fmt.Errorf("latest deployment %q is %s", d.ID, d.Status)
+			}
+
+			return nil
+		},
+	}
+}
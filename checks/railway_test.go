@@ -0,0 +1,52 @@
+package checks
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestNewRailwayCheck(t *testing.T) {
+	tests := map[string]struct {
+		body    string
+		wantErr bool
+	}{
+		"deployed": {
+			body: `{"data":{"deployments":{"edges":[{"node":{"id":"d1","status":"SUCCESS"}}]}}}`,
+		},
+		"crashed": {
+			body:    `{"data":{"deployments":{"edges":[{"node":{"id":"d1","status":"CRASHED"}}]}}}`,
+			wantErr: true,
+		},
+		"no deployment": {
+			body:    `{"data":{"deployments":{"edges":[]}}}`,
+			wantErr: true,
+		},
+		"query error": {
+			body:    `{"errors":[{"message":"Not Authorized"}]}`,
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &railwayAPI, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.Header.Get("Authorization") != "Bearer token" {
+					t.Errorf("unexpected request %s with authorization %q", r.Method, r.Header.Get("Authorization"))
+				}
+
+				var query struct {
+					Query     string            `json:"query"`
+					Variables map[string]string `json:"variables"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&query); err != nil || query.Query == "" || query.Variables["serviceId"] != "svc" {
+					t.Errorf("unexpected query %+v: %v", query, err)
+				}
+
+				respondJSON(http.StatusOK, tt.body)(w, r)
+			})
+
+			assertCheck(t, NewRailwayCheck("railway", "svc", "token").Check, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// CheckRateLimiter creates a check which reports a degradation when the tokens available in a rate limiter,
+// as returned by availFn, drop below minAvailable, signalling saturation.
+func CheckRateLimiter(availFn func() int, minAvailable int) health.CheckFunc {
+	return func(ctx context.Context) error {
+		if avail := availFn(); avail < minAvailable {
+			return fmt.Errorf("%w: rate limiter has %d tokens available, expected at least %d", health.ErrDegraded, avail, minAvailable)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,34 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pcordeiro/go-health"
+)
+
+func TestCheckRateLimiter(t *testing.T) {
+	tests := map[string]struct {
+		available int
+		wantErr   bool
+	}{
+		"plenty of tokens": {available: 100},
+		"at the minimum":   {available: 10},
+		"saturated":        {available: 9, wantErr: true},
+		"exhausted":        {available: 0, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := CheckRateLimiter(func() int { return tt.available }, 10)(context.Background())
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v, got %v", tt.wantErr, err)
+			}
+			if err != nil && !errors.Is(err, health.ErrDegraded) {
+				t.Errorf("expected a degradation, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// NewRedpandaCheck creates a check which calls the Redpanda admin API cluster health overview and verifies
+// the cluster is healthy with all nodes alive.
+func NewRedpandaCheck(name, adminURL string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			req, err := newRequest(ctx, http.MethodGet, strings.TrimSuffix(adminURL, "/")+"/v1/cluster/health_overview", nil, map[string]string{
+				"Accept": "application/json",
+			})
+			if err != nil {
+				return err
+			}
+
+			var res struct {
+				IsHealthy     bool `json:"is_healthy"`
+				AllNodesAlive bool `json:"all_nodes_alive"`
+			}
+			if err := getJSON(req, &res); err != nil {
+				return err
+			}
+
+			if !res.AllNodesAlive {
+				return errors.New("not all cluster nodes are alive")
+			}
+
+			if !res.IsHealthy {
+				return errors.New("cluster is not healthy")
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,33 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewRedpandaCheck(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		body    string
+		wantErr bool
+	}{
+		"healthy":        {status: http.StatusOK, body: `{"is_healthy":true,"all_nodes_alive":true}`},
+		"node down":      {status: http.StatusOK, body: `{"is_healthy":false,"all_nodes_alive":false}`, wantErr: true},
+		"leaderless":     {status: http.StatusOK, body: `{"is_healthy":false,"all_nodes_alive":true}`, wantErr: true},
+		"admin api down": {status: http.StatusInternalServerError, body: `{}`, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := mockAPI(t, nil, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/cluster/health_overview" {
+					t.Errorf("unexpected path %q", r.URL.Path)
+				}
+
+				respondJSON(tt.status, tt.body)(w, r)
+			})
+
+			assertCheck(t, NewRedpandaCheck("redpanda", srv.URL).Check, tt.wantErr)
+		})
+	}
+}
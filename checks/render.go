@@ -0,0 +1,68 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/pcordeiro/go-health"
+)
+
+var renderAPI = "https://api.render.com/v1"
+
+// NewRenderCheck creates a check which calls the Render API to verify the service is not suspended and its
+// latest deploy is live.
+func NewRenderCheck(name, serviceID, apiKey string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			path := "/services/" + url.PathEscape(serviceID)
+
+			var service struct {
+				Suspended string `json:"suspended"`
+			}
+			if err := renderGet(ctx, apiKey, path, &service); err != nil {
+				return err
+			}
+
+			if service.Suspended != "not_suspended" {
+				return fmt.Errorf("service %q is %s", serviceID, service.Suspended)
+			}
+
+			var deploys []struct {
+				Deploy struct {
+					ID     string `json:"id"`
+					Status string `json:"status"`
+				} `json:"deploy"`
+			}
+			if err := renderGet(ctx, apiKey, path+"/deploys?limit=1", &deploys); err != nil {
+				return err
+			}
+
+			if len(deploys) == 0 {
+				return errors.New("service has no deploy")
+			}
+
+			if d := deploys[0].Deploy; d.Status != "live" {
+				return fmt.Errorf("latest deploy %q is %s", d.ID, d.Status)
+			}
+
+			return nil
+		},
+	}
+}
+
+// renderGet calls the Render API at path and decodes the response into v.
+func renderGet(ctx context.Context, apiKey, path string, v any) error {
+	req, err := newRequest(ctx, http.MethodGet, renderAPI+path, nil, map[string]string{
+		"Authorization": "Bearer " + apiKey,
+		"Accept":        "application/json",
+	})
+	if err != nil {
+		return err
+	}
+
+	return getJSON(req, v)
+}
@@ -0,0 +1,58 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewRenderCheck(t *testing.T) {
+	const live = `[{"deploy":{"id":"dep-1","status":"live"}}]`
+
+	tests := map[string]struct {
+		service, deploys string
+		wantErr          bool
+	}{
+		"live": {
+			service: `{"suspended":"not_suspended"}`,
+			deploys: live,
+		},
+		"suspended": {
+			service: `{"suspended":"suspended"}`,
+			deploys: live,
+			wantErr: true,
+		},
+		"deploy failed": {
+			service: `{"suspended":"not_suspended"}`,
+			deploys: `[{"deploy":{"id":"dep-2","status":"build_failed"}}]`,
+			wantErr: true,
+		},
+		"no deploy": {
+			service: `{"suspended":"not_suspended"}`,
+			deploys: `[]`,
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &renderAPI, func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Authorization"); got != "Bearer key" {
+					t.Errorf("unexpected authorization %q", got)
+				}
+
+				routes(map[string]http.HandlerFunc{
+					"/services/srv-1":                 respondJSON(http.StatusOK, tt.service),
+					"/services/srv-1/deploys?limit=1": respondJSON(http.StatusOK, tt.deploys),
+				})(w, r)
+			})
+
+			assertCheck(t, NewRenderCheck("render", "srv-1", "key").Check, tt.wantErr)
+		})
+	}
+
+	t.Run("invalid key", func(t *testing.T) {
+		mockAPI(t, &renderAPI, respondJSON(http.StatusUnauthorized, `{"message":"unauthorized"}`))
+
+		assertCheck(t, NewRenderCheck("render", "srv-1", "key").Check, true)
+	})
+}
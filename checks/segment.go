@@ -0,0 +1,32 @@
+package checks
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/pcordeiro/go-health"
+)
+
+var segmentAPI = "https://api.segment.io"
+
+// NewSegmentCheck creates a check which sends a minimal track call to the Segment API and expects a 200 response,
+// confirming the write key is valid and the API is reachable.
+func NewSegmentCheck(name, writeKey string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			body := `{"userId":"go-health","event":"Health Check"}`
+
+			req, err := newRequest(ctx, http.MethodPost, segmentAPI+"/v1/track", strings.NewReader(body), map[string]string{
+				"Content-Type": "application/json",
+			})
+			if err != nil {
+				return err
+			}
+			req.SetBasicAuth(writeKey, "")
+
+			return expectStatus(req, http.StatusOK)
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package checks
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestNewSegmentCheck(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		wantErr bool
+	}{
+		"valid write key":   {status: http.StatusOK},
+		"invalid write key": {status: http.StatusUnauthorized, wantErr: true},
+		"outage":            {status: http.StatusBadGateway, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &segmentAPI, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v1/track" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				if user, _, ok := r.BasicAuth(); !ok || user != "write-key" {
+					t.Errorf("unexpected write key %q", user)
+				}
+
+				var event struct {
+					UserID string `json:"userId"`
+					Event  string `json:"event"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&event); err != nil || event.UserID == "" || event.Event == "" {
+					t.Errorf("invalid track call %+v: %v", event, err)
+				}
+
+				respondJSON(tt.status, `{"success":true}`)(w, r)
+			})
+
+			assertCheck(t, NewSegmentCheck("segment", "write-key").Check, tt.wantErr)
+		})
+	}
+}
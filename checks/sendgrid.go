@@ -0,0 +1,27 @@
+package checks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pcordeiro/go-health"
+)
+
+var sendGridAPI = "https://api.sendgrid.com"
+
+// NewSendGridCheck creates a check which calls the SendGrid credits endpoint with the API key and expects a 200 response.
+func NewSendGridCheck(name, apiKey string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			req, err := newRequest(ctx, http.MethodGet, sendGridAPI+"/v3/user/credits", nil, map[string]string{
+				"Authorization": "Bearer " + apiKey,
+			})
+			if err != nil {
+				return err
+			}
+
+			return expectStatus(req, http.StatusOK)
+		},
+	}
+}
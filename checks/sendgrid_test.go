@@ -0,0 +1,33 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewSendGridCheck(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		wantErr bool
+	}{
+		"valid key":   {status: http.StatusOK},
+		"invalid key": {status: http.StatusUnauthorized, wantErr: true},
+		"forbidden":   {status: http.StatusForbidden, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &sendGridAPI, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v3/user/credits" {
+					t.Errorf("unexpected path %q", r.URL.Path)
+				}
+				if got := r.Header.Get("Authorization"); got != "Bearer SG.key" {
+					t.Errorf("unexpected authorization %q", got)
+				}
+				respondJSON(tt.status, `{}`)(w, r)
+			})
+
+			assertCheck(t, NewSendGridCheck("sendgrid", "SG.key").Check, tt.wantErr)
+		})
+	}
+}
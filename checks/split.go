@@ -0,0 +1,27 @@
+package checks
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// SplitFactory is the part of *client.SplitFactory, from the Split.io SDK, used by NewSplitCheck.
+type SplitFactory interface {
+	IsReady() bool
+}
+
+// NewSplitCheck creates a check which fails if the Split.io SDK is not ready.
+func NewSplitCheck(name string, factory SplitFactory) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			if !factory.IsReady() {
+				return errors.New("Split SDK is not ready")
+			}
+
+			return nil
+		},
+	}
+}
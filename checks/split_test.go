@@ -0,0 +1,15 @@
+package checks
+
+import "testing"
+
+// fakeSplitFactory reports the readiness it was created with.
+type fakeSplitFactory bool
+
+func (f fakeSplitFactory) IsReady() bool {
+	return bool(f)
+}
+
+func TestNewSplitCheck(t *testing.T) {
+	assertCheck(t, NewSplitCheck("split", fakeSplitFactory(true)).Check, false)
+	assertCheck(t, NewSplitCheck("split", fakeSplitFactory(false)).Check, true)
+}
@@ -0,0 +1,93 @@
+package checks
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+)
+
+// fakeDriverName is the name of the fakeDriver registered with database/sql.
+const fakeDriverName = "fake"
+
+// fakeSQL is the fakeDriver registered with database/sql.
+var fakeSQL = &fakeDriver{}
+
+func init() {
+	sql.Register(fakeDriverName, fakeSQL)
+}
+
+// fakeDriver is a database/sql driver whose connections answer every query with a single row holding the
+// value of the "value" DSN parameter, or fail when the DSN contains "unreachable". It records the opened DSNs.
+type fakeDriver struct {
+	mu   sync.Mutex
+	dsns []string
+}
+
+func (d *fakeDriver) Open(dsn string) (driver.Conn, error) {
+	d.mu.Lock()
+	d.dsns = append(d.dsns, dsn)
+	d.mu.Unlock()
+
+	if strings.Contains(dsn, "unreachable") {
+		return nil, errors.New("dial tcp: connection refused")
+	}
+
+	value := "1"
+	if i := strings.Index(dsn, "value="); i >= 0 {
+		value = strings.SplitN(dsn[i+len("value="):], "&", 2)[0]
+	}
+
+	return fakeSQLConn{value: value}, nil
+}
+
+// lastDSN returns the DSN of the last opened connection.
+func (d *fakeDriver) lastDSN() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(d.dsns) == 0 {
+		return ""
+	}
+
+	return d.dsns[len(d.dsns)-1]
+}
+
+type fakeSQLConn struct {
+	value string
+}
+
+func (c fakeSQLConn) Prepare(string) (driver.Stmt, error) { return fakeSQLStmt(c), nil }
+func (c fakeSQLConn) Close() error                        { return nil }
+func (c fakeSQLConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type fakeSQLStmt fakeSQLConn
+
+func (s fakeSQLStmt) Close() error                               { return nil }
+func (s fakeSQLStmt) NumInput() int                              { return -1 }
+func (s fakeSQLStmt) Exec([]driver.Value) (driver.Result, error) { return driver.ResultNoRows, nil }
+func (s fakeSQLStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &fakeSQLRows{value: s.value}, nil
+}
+
+type fakeSQLRows struct {
+	value string
+	done  bool
+}
+
+func (r *fakeSQLRows) Columns() []string { return []string{"value"} }
+func (r *fakeSQLRows) Close() error      { return nil }
+
+func (r *fakeSQLRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = r.value
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package checks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pcordeiro/go-health"
+)
+
+var stripeAPI = "https://api.stripe.com"
+
+// NewStripeCheck creates a check which calls the Stripe balance endpoint with the API key and expects a 200 response,
+// verifying both the API availability and the validity of the key.
+func NewStripeCheck(name, apiKey string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			req, err := newRequest(ctx, http.MethodGet, stripeAPI+"/v1/balance", nil, map[string]string{
+				"Authorization": "Bearer " + apiKey,
+			})
+			if err != nil {
+				return err
+			}
+
+			return expectStatus(req, http.StatusOK)
+		},
+	}
+}
@@ -0,0 +1,33 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewStripeCheck(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		wantErr bool
+	}{
+		"valid key":   {status: http.StatusOK},
+		"invalid key": {status: http.StatusUnauthorized, wantErr: true},
+		"outage":      {status: http.StatusInternalServerError, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &stripeAPI, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/balance" {
+					t.Errorf("unexpected path %q", r.URL.Path)
+				}
+				if got := r.Header.Get("Authorization"); got != "Bearer sk_test" {
+					t.Errorf("unexpected authorization %q", got)
+				}
+				respondJSON(tt.status, `{}`)(w, r)
+			})
+
+			assertCheck(t, NewStripeCheck("stripe", "sk_test").Check, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package checks
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// NewSupabaseCheck creates a check which calls the PostgREST API of the project with the anon key and expects a
+// 200 response. PostgREST queries the project's Postgres database for the tables and functions the role can access
+// to answer, so the check also fails with a 503 response when the database is unreachable.
+func NewSupabaseCheck(name, projectURL, anonKey string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			req, err := newRequest(ctx, http.MethodGet, strings.TrimSuffix(projectURL, "/")+"/rest/v1/", nil, map[string]string{
+				"apikey":        anonKey,
+				"Authorization": "Bearer " + anonKey,
+			})
+			if err != nil {
+				return err
+			}
+
+			return expectStatus(req, http.StatusOK)
+		},
+	}
+}
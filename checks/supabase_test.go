@@ -0,0 +1,34 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewSupabaseCheck(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		body    string
+		wantErr bool
+	}{
+		"healthy":          {status: http.StatusOK, body: `{"swagger":"2.0","paths":{}}`},
+		"invalid anon key": {status: http.StatusUnauthorized, body: `{"message":"Invalid API key"}`, wantErr: true},
+		"database down":    {status: http.StatusServiceUnavailable, body: `{"code":"PGRST001","message":"Database client error"}`, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := mockAPI(t, nil, func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("apikey") != "anon" || r.Header.Get("Authorization") != "Bearer anon" {
+					t.Errorf("unexpected credentials %v", r.Header)
+				}
+
+				routes(map[string]http.HandlerFunc{
+					"/rest/v1/": respondJSON(tt.status, tt.body),
+				})(w, r)
+			})
+
+			assertCheck(t, NewSupabaseCheck("supabase", srv.URL+"/", "anon").Check, tt.wantErr)
+		})
+	}
+}
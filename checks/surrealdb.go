@@ -0,0 +1,79 @@
+package checks
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// websocketGUID is the magic value used to compute Sec-WebSocket-Accept (RFC 6455).
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// NewSurrealDBCheck creates a check which calls the SurrealDB /health endpoint and expects a 200 response.
+func NewSurrealDBCheck(name, url string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			req, err := newRequest(ctx, http.MethodGet, strings.TrimSuffix(url, "/")+"/health", nil, nil)
+			if err != nil {
+				return err
+			}
+
+			return expectStatus(req, http.StatusOK)
+		},
+	}
+}
+
+// NewSurrealDBWebSocketCheck creates a check which opens a WebSocket connection to the SurrealDB
+// /rpc endpoint, the one used by the SurrealDB protocol, and closes it once the handshake succeeds.
+func NewSurrealDBWebSocketCheck(name, url string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			key := make([]byte, 16)
+			if _, err := rand.Read(key); err != nil {
+				return fmt.Errorf("could not generate websocket key: %w", err)
+			}
+			encodedKey := base64.StdEncoding.EncodeToString(key)
+
+			u := strings.TrimSuffix(url, "/") + "/rpc"
+			u = strings.Replace(u, "ws://", "http://", 1)
+			u = strings.Replace(u, "wss://", "https://", 1)
+
+			req, err := newRequest(ctx, http.MethodGet, u, nil, map[string]string{
+				"Connection":             "Upgrade",
+				"Upgrade":                "websocket",
+				"Sec-WebSocket-Version":  "13",
+				"Sec-WebSocket-Key":      encodedKey,
+				"Sec-WebSocket-Protocol": "json",
+			})
+			if err != nil {
+				return err
+			}
+
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return fmt.Errorf("request failed: %w", err)
+			}
+			defer res.Body.Close()
+
+			if res.StatusCode != http.StatusSwitchingProtocols {
+				return fmt.Errorf("websocket handshake failed with status code %d", res.StatusCode)
+			}
+
+			sum := sha1.Sum([]byte(encodedKey + websocketGUID))
+			if res.Header.Get("Sec-WebSocket-Accept") != base64.StdEncoding.EncodeToString(sum[:]) {
+				return errors.New("websocket handshake returned an invalid accept key")
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,76 @@
+package checks
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestNewSurrealDBCheck(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		wantErr bool
+	}{
+		"healthy":   {status: http.StatusOK},
+		"unhealthy": {status: http.StatusInternalServerError, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := mockAPI(t, nil, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/health" {
+					t.Errorf("unexpected path %q", r.URL.Path)
+				}
+				w.WriteHeader(tt.status)
+			})
+
+			assertCheck(t, NewSurrealDBCheck("surrealdb", srv.URL+"/").Check, tt.wantErr)
+		})
+	}
+}
+
+func TestNewSurrealDBWebSocketCheck(t *testing.T) {
+	tests := map[string]struct {
+		accept  func(key string) string
+		status  int
+		wantErr bool
+	}{
+		"handshake succeeds": {
+			accept: func(key string) string {
+				sum := sha1.Sum([]byte(key + websocketGUID))
+				return base64.StdEncoding.EncodeToString(sum[:])
+			},
+			status: http.StatusSwitchingProtocols,
+		},
+		"invalid accept key": {
+			accept:  func(string) string { return "invalid" },
+			status:  http.StatusSwitchingProtocols,
+			wantErr: true,
+		},
+		"upgrade refused": {
+			accept:  func(string) string { return "" },
+			status:  http.StatusBadRequest,
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := mockAPI(t, nil, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/rpc" || r.Header.Get("Upgrade") != "websocket" {
+					t.Errorf("unexpected request %s with upgrade %q", r.URL.Path, r.Header.Get("Upgrade"))
+				}
+
+				w.Header().Set("Connection", "Upgrade")
+				w.Header().Set("Upgrade", "websocket")
+				w.Header().Set("Sec-WebSocket-Accept", tt.accept(r.Header.Get("Sec-WebSocket-Key")))
+				w.WriteHeader(tt.status)
+			})
+
+			url := strings.Replace(srv.URL, "http://", "ws://", 1)
+			assertCheck(t, NewSurrealDBWebSocketCheck("surrealdb", url).Check, tt.wantErr)
+		})
+	}
+}
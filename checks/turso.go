@@ -0,0 +1,46 @@
+package checks
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// tursoDriver is the database/sql driver name used by the Turso check.
+var tursoDriver = "libsql"
+
+// NewTursoCheck creates a check which opens a libSQL connection to the database and executes SELECT 1.
+// The libSQL driver must be registered, e.g. by importing github.com/tursodatabase/libsql-client-go/libsql,
+// the same way SQL drivers are for other database checks. authToken is ignored when empty, as for local files.
+func NewTursoCheck(name, databaseURL, authToken string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			dsn := databaseURL
+			if authToken != "" {
+				sep := "?"
+				if strings.Contains(dsn, "?") {
+					sep = "&"
+				}
+				dsn += sep + "authToken=" + url.QueryEscape(authToken)
+			}
+
+			db, err := sql.Open(tursoDriver, dsn)
+			if err != nil {
+				return fmt.Errorf("could not open database: %w", err)
+			}
+			defer db.Close()
+
+			var one int
+			if err := db.QueryRowContext(ctx, "SELECT 1").Scan(&one); err != nil {
+				return fmt.Errorf("could not query database: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
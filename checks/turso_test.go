@@ -0,0 +1,46 @@
+package checks
+
+import "testing"
+
+func TestNewTursoCheck(t *testing.T) {
+	original := tursoDriver
+	tursoDriver = fakeDriverName
+	t.Cleanup(func() { tursoDriver = original })
+
+	tests := map[string]struct {
+		url, token string
+		wantDSN    string
+		wantErr    bool
+	}{
+		"remote database": {
+			url:     "libsql://db-org.turso.io",
+			token:   "a+b",
+			wantDSN: "libsql://db-org.turso.io?authToken=a%2Bb",
+		},
+		"url with parameters": {
+			url:     "libsql://db-org.turso.io?tls=1",
+			token:   "token",
+			wantDSN: "libsql://db-org.turso.io?tls=1&authToken=token",
+		},
+		"local file": {
+			url:     "file:local.db",
+			wantDSN: "file:local.db",
+		},
+		"unreachable": {
+			url:     "libsql://unreachable.turso.io",
+			token:   "token",
+			wantDSN: "libsql://unreachable.turso.io?authToken=token",
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assertCheck(t, NewTursoCheck("turso", tt.url, tt.token).Check, tt.wantErr)
+
+			if got := fakeSQL.lastDSN(); got != tt.wantDSN {
+				t.Errorf("expected DSN %q, got %q", tt.wantDSN, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package checks
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/pcordeiro/go-health"
+)
+
+var twilioAPI = "https://api.twilio.com"
+
+// NewTwilioCheck creates a check which fetches the Twilio account and expects a 200 response.
+func NewTwilioCheck(name, accountSID, authToken string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			req, err := newRequest(ctx, http.MethodGet, twilioAPI+"/2010-04-01/Accounts/"+url.PathEscape(accountSID)+".json", nil, nil)
+			if err != nil {
+				return err
+			}
+			req.SetBasicAuth(accountSID, authToken)
+
+			return expectStatus(req, http.StatusOK)
+		},
+	}
+}
@@ -0,0 +1,33 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewTwilioCheck(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		wantErr bool
+	}{
+		"valid credentials":   {status: http.StatusOK},
+		"invalid credentials": {status: http.StatusUnauthorized, wantErr: true},
+		"unknown account":     {status: http.StatusNotFound, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &twilioAPI, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/2010-04-01/Accounts/AC123.json" {
+					t.Errorf("unexpected path %q", r.URL.Path)
+				}
+				if user, pass, ok := r.BasicAuth(); !ok || user != "AC123" || pass != "token" {
+					t.Errorf("unexpected basic auth %q:%q", user, pass)
+				}
+				respondJSON(tt.status, `{}`)(w, r)
+			})
+
+			assertCheck(t, NewTwilioCheck("twilio", "AC123", "token").Check, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// NewUnleashCheck creates a check which calls the Unleash server /health endpoint and verifies the reported status is UP.
+func NewUnleashCheck(name, unleashURL, instanceID string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			req, err := newRequest(ctx, http.MethodGet, strings.TrimSuffix(unleashURL, "/")+"/health", nil, map[string]string{
+				"UNLEASH-INSTANCEID": instanceID,
+				"Accept":             "application/json",
+			})
+			if err != nil {
+				return err
+			}
+
+			var res struct {
+				Status string `json:"status"`
+			}
+			if err := getJSON(req, &res); err != nil {
+				return err
+			}
+
+			if res.Status != "UP" {
+				return fmt.Errorf("Unleash server status is %q", res.Status)
+			}
+
+			return nil
+		},
+	}
+}
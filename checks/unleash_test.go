@@ -0,0 +1,34 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewUnleashCheck(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		body    string
+		wantErr bool
+	}{
+		"up":          {status: http.StatusOK, body: `{"health":"GOOD","status":"UP"}`},
+		"down":        {status: http.StatusOK, body: `{"status":"DOWN"}`, wantErr: true},
+		"unavailable": {status: http.StatusServiceUnavailable, body: `{}`, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := mockAPI(t, nil, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/health" {
+					t.Errorf("unexpected path %q", r.URL.Path)
+				}
+				if got := r.Header.Get("UNLEASH-INSTANCEID"); got != "instance" {
+					t.Errorf("unexpected instance id %q", got)
+				}
+				respondJSON(tt.status, tt.body)(w, r)
+			})
+
+			assertCheck(t, NewUnleashCheck("unleash", srv.URL+"/api/", "instance").Check, tt.wantErr)
+		})
+	}
+}
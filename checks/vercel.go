@@ -0,0 +1,45 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/pcordeiro/go-health"
+)
+
+var vercelAPI = "https://api.vercel.com"
+
+// NewVercelCheck creates a check which calls the Vercel API to verify the deployment of the project is ready.
+func NewVercelCheck(name, projectID, deploymentID, apiToken string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			req, err := newRequest(ctx, http.MethodGet, vercelAPI+"/v13/deployments/"+url.PathEscape(deploymentID), nil, map[string]string{
+				"Authorization": "Bearer " + apiToken,
+			})
+			if err != nil {
+				return err
+			}
+
+			var deployment struct {
+				ProjectID  string `json:"projectId"`
+				ReadyState string `json:"readyState"`
+			}
+			if err := getJSON(req, &deployment); err != nil {
+				return err
+			}
+
+			if deployment.ProjectID != projectID {
+				return fmt.Errorf("deployment %q belongs to project %q, expected %q", deploymentID, deployment.ProjectID, projectID)
+			}
+
+			if deployment.ReadyState != "READY" {
+				return fmt.Errorf("deployment %q is %s", deploymentID, deployment.ReadyState)
+			}
+
+			return nil
+		},
+	}
+}
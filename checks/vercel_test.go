@@ -0,0 +1,35 @@
+package checks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewVercelCheck(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		body    string
+		wantErr bool
+	}{
+		"ready":         {status: http.StatusOK, body: `{"projectId":"prj_1","readyState":"READY"}`},
+		"building":      {status: http.StatusOK, body: `{"projectId":"prj_1","readyState":"BUILDING"}`, wantErr: true},
+		"other project": {status: http.StatusOK, body: `{"projectId":"prj_2","readyState":"READY"}`, wantErr: true},
+		"not found":     {status: http.StatusNotFound, body: `{"error":{"code":"not_found"}}`, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI(t, &vercelAPI, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v13/deployments/dpl_1" {
+					t.Errorf("unexpected path %q", r.URL.Path)
+				}
+				if got := r.Header.Get("Authorization"); got != "Bearer token" {
+					t.Errorf("unexpected authorization %q", got)
+				}
+				respondJSON(tt.status, tt.body)(w, r)
+			})
+
+			assertCheck(t, NewVercelCheck("vercel", "prj_1", "dpl_1", "token").Check, tt.wantErr)
+		})
+	}
+}
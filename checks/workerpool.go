@@ -0,0 +1,26 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// CheckWorkerPool creates a check which reports a degradation when the utilization of a worker pool, active
+// workers over capacity as returned by statsFn, exceeds maxUtilization. A pool without capacity fails.
+func CheckWorkerPool(statsFn func() (active, capacity int), maxUtilization float64) health.CheckFunc {
+	return func(ctx context.Context) error {
+		active, capacity := statsFn()
+		if capacity <= 0 {
+			return errors.New("worker pool has no capacity")
+		}
+
+		if utilization := float64(active) / float64(capacity); utilization > maxUtilization {
+			return fmt.Errorf("%w: worker pool utilization is %d/%d (%.0f%%)", health.ErrDegraded, active, capacity, utilization*100)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,37 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pcordeiro/go-health"
+)
+
+func TestCheckWorkerPool(t *testing.T) {
+	tests := map[string]struct {
+		active, capacity int
+		wantErr          bool
+		wantDegraded     bool
+	}{
+		"idle":                {active: 0, capacity: 10},
+		"at the threshold":    {active: 8, capacity: 10},
+		"above the threshold": {active: 9, capacity: 10, wantErr: true, wantDegraded: true},
+		"saturated":           {active: 10, capacity: 10, wantErr: true, wantDegraded: true},
+		"zero capacity":       {active: 0, capacity: 0, wantErr: true},
+		"negative capacity":   {active: 1, capacity: -1, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := CheckWorkerPool(func() (int, int) { return tt.active, tt.capacity }, 0.8)(context.Background())
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v, got %v", tt.wantErr, err)
+			}
+			if errors.Is(err, health.ErrDegraded) != tt.wantDegraded {
+				t.Errorf("expected a degradation: %v, got %v", tt.wantDegraded, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by a circuit broken check while its circuit is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitOptions configures CircuitBreak.
+type CircuitOptions struct {
+	// Threshold is the number of consecutive failures which opens the circuit. Defaults to 5.
+	Threshold int
+	// Cooldown is how long the circuit stays open before a trial call is let through. Defaults to 30 seconds.
+	Cooldown time.Duration
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// CircuitBreak wraps a check with a circuit breaker. After Threshold consecutive failures the circuit opens
+// and the check is not called for Cooldown, failing immediately instead. Once the cooldown elapses the circuit
+// is half-open: a single trial call is let through, closing the circuit on success or reopening it on failure.
+func CircuitBreak(c CheckFunc, opts CircuitOptions) CheckFunc {
+	if opts.Threshold <= 0 {
+		opts.Threshold = 5
+	}
+
+	if opts.Cooldown <= 0 {
+		opts.Cooldown = 30 * time.Second
+	}
+
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+
+	var (
+		mu       sync.Mutex
+		failures int
+		openedAt time.Time
+		trial    bool
+		lastErr  error
+	)
+
+	return func(ctx context.Context) error {
+		mu.Lock()
+		if failures >= opts.Threshold {
+			if trial || opts.Now().Sub(openedAt) < opts.Cooldown {
+				err := lastErr
+				mu.Unlock()
+
+				return fmt.Errorf("%w: %v", ErrCircuitOpen, err)
+			}
+
+			trial = true
+		}
+		mu.Unlock()
+
+		// a panic counts as a failure, so it cannot leave a trial call pending forever
+		err := callCheck(ctx, c)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		trial = false
+
+		if err == nil {
+			failures = 0
+			return nil
+		}
+
+		failures++
+		lastErr = err
+		if failures >= opts.Threshold {
+			openedAt = opts.Now()
+		}
+
+		return err
+	}
+}
@@ -0,0 +1,87 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreak(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	var (
+		calls int
+		err   error
+	)
+	check := CircuitBreak(func(context.Context) error {
+		calls++
+		return err
+	}, CircuitOptions{Threshold: 2, Cooldown: time.Minute, Now: clock})
+
+	ctx := context.Background()
+
+	err = errors.New("down")
+	for i := 0; i < 2; i++ {
+		if got := check(ctx); errors.Is(got, ErrCircuitOpen) {
+			t.Fatalf("expected the circuit to be closed on call %d, got %v", i+1, got)
+		}
+	}
+
+	// open: the check is not called until the cooldown elapses
+	if got := check(ctx); !errors.Is(got, ErrCircuitOpen) {
+		t.Fatalf("expected the circuit to be open, got %v", got)
+	}
+	if calls != 2 {
+		t.Fatalf("expected the check not to be called while open, got %d calls", calls)
+	}
+
+	// half-open: a failing trial reopens the circuit
+	now = now.Add(time.Minute)
+	if got := check(ctx); errors.Is(got, ErrCircuitOpen) || calls != 3 {
+		t.Fatalf("expected a trial call, got %v after %d calls", got, calls)
+	}
+	if got := check(ctx); !errors.Is(got, ErrCircuitOpen) {
+		t.Fatalf("expected the circuit to reopen after a failed trial, got %v", got)
+	}
+
+	// half-open: a successful trial closes the circuit
+	now = now.Add(time.Minute)
+	err = nil
+	if got := check(ctx); got != nil {
+		t.Fatalf("expected the trial to succeed, got %v", got)
+	}
+	if got := check(ctx); got != nil || calls != 5 {
+		t.Fatalf("expected the circuit to be closed, got %v after %d calls", got, calls)
+	}
+}
+
+func TestCircuitBreakPanickingTrial(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	panics := true
+	check := CircuitBreak(func(context.Context) error {
+		if panics {
+			panic("boom")
+		}
+		return nil
+	}, CircuitOptions{Threshold: 1, Cooldown: time.Minute, Now: func() time.Time { return now }})
+
+	ctx := context.Background()
+
+	if err := check(ctx); err == nil || errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected the panic to be reported as a failure, got %v", err)
+	}
+
+	now = now.Add(time.Minute)
+	if err := check(ctx); err == nil || errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected a panicking trial call, got %v", err)
+	}
+
+	now = now.Add(time.Minute)
+	panics = false
+	if err := check(ctx); err != nil {
+		t.Fatalf("expected a new trial once the cooldown elapsed, got %v", err)
+	}
+}
@@ -0,0 +1,38 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestFailureCodes(t *testing.T) {
+	h := newHealth(t, WithChecks(
+		Check{Name: "db", Check: checkFunc(codedError{"ECONNREFUSED", "connection refused"})},
+		Check{Name: "cache", Check: checkFunc(fmt.Errorf("dial: %w", codedError{"EAUTH", "denied"}))},
+		Check{Name: "queue", Check: checkFunc(errors.New("no code"))},
+		Check{Name: "search", Timeout: 10 * time.Millisecond, Check: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+		Check{Name: "blob", Check: checkFunc(nil)},
+	))
+
+	r := h.Check(context.Background())
+
+	want := map[string]string{"db": "ECONNREFUSED", "cache": "EAUTH", "search": "timeout"}
+	if len(r.FailureCodes) != len(want) {
+		t.Errorf("expected failure codes %v, got %v", want, r.FailureCodes)
+	}
+	for name, code := range want {
+		if r.FailureCodes[name] != code {
+			t.Errorf("expected the code of %q to be %q, got %q", name, code, r.FailureCodes[name])
+		}
+	}
+
+	if r.Failures["cache"] != "dial: denied" {
+		t.Errorf("expected the message to be kept along with the code, got %q", r.Failures["cache"])
+	}
+}
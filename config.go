@@ -0,0 +1,62 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// CheckConfig describes the configuration of a registered check.
+type CheckConfig struct {
+	Name          string        `json:"name"`
+	Description   string        `json:"description,omitempty"`
+	Category      string        `json:"category,omitempty"`
+	Timeout       time.Duration `json:"timeout"`
+	SkipOnErr     bool          `json:"skip_on_err"`
+	Critical      bool          `json:"critical"`
+	DependencyKey string        `json:"dependency_key,omitempty"`
+	LastStatus    Status        `json:"last_status"`
+}
+
+// Config returns the configuration of the registered checks, sorted by name.
+func (h *Health) Config() []CheckConfig {
+	checks := h.registered()
+
+	h.mu.Lock()
+	var last map[string]CheckResult
+	if h.last != nil {
+		last = h.last.Checks
+	}
+	h.mu.Unlock()
+
+	configs := make([]CheckConfig, 0, len(checks))
+	for _, c := range checks {
+		status := StatusUnknown
+		if r, ok := last[c.Name]; ok {
+			status = r.Status
+		}
+
+		configs = append(configs, CheckConfig{
+			Name:          c.Name,
+			Description:   c.Description,
+			Category:      c.Category,
+			Timeout:       c.Timeout,
+			SkipOnErr:     c.SkipOnErr,
+			Critical:      !c.SkipOnErr,
+			DependencyKey: c.DependencyKey,
+			LastStatus:    status,
+		})
+	}
+	sort.Slice(configs, func(i, j int) bool { return configs[i].Name < configs[j].Name })
+
+	return configs
+}
+
+// ConfigHandler returns a handler responding with the configuration of the registered checks as JSON.
+func (h *Health) ConfigHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(h.Config())
+	})
+}
@@ -0,0 +1,62 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestConfigHandler(t *testing.T) {
+	h := newHealth(t, WithChecks(
+		Check{
+			Name:          "postgres",
+			Description:   "Primary database, stores the orders.",
+			Category:      "storage",
+			Timeout:       time.Second,
+			DependencyKey: "postgres-cluster",
+			Check:         checkFunc(errors.New("down")),
+		},
+		Check{Name: "cache", SkipOnErr: true, Check: checkFunc(nil)},
+	))
+
+	w := serve(t, h.ConfigHandler(), "/health/config")
+
+	var before []CheckConfig
+	if err := json.Unmarshal(w.Body.Bytes(), &before); err != nil {
+		t.Fatalf("could not decode the config: %v", err)
+	}
+
+	want := []CheckConfig{
+		{Name: "cache", Timeout: 2 * time.Second, SkipOnErr: true, LastStatus: StatusUnknown},
+		{
+			Name:          "postgres",
+			Description:   "Primary database, stores the orders.",
+			Category:      "storage",
+			Timeout:       time.Second,
+			Critical:      true,
+			DependencyKey: "postgres-cluster",
+			LastStatus:    StatusUnknown,
+		},
+	}
+	if !reflect.DeepEqual(before, want) {
+		t.Errorf("expected config %+v, got %+v", want, before)
+	}
+
+	h.Check(context.Background())
+
+	after := h.Config()
+	if after[0].LastStatus != StatusOK || after[1].LastStatus != StatusUnavailable {
+		t.Errorf("expected the last statuses of the run, got %q and %q", after[0].LastStatus, after[1].LastStatus)
+	}
+}
+
+func TestDescriptionDoesNotAffectExecution(t *testing.T) {
+	h := newHealth(t, WithChecks(Check{Name: "db", Description: "Fails on purpose.", Check: checkFunc(nil)}))
+
+	if r := h.Check(context.Background()); r.Status != StatusOK {
+		t.Errorf("expected status %q, got %q", StatusOK, r.Status)
+	}
+}
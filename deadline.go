@@ -0,0 +1,33 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CheckRespectsDeadline creates a check which performs fn with a deadline of expected and fails if fn ignores it,
+// i.e. is still running after twice expected. The outcome of fn itself is not relevant, catching dependencies
+// which do not honor cancellation is.
+func CheckRespectsDeadline(fn CheckFunc, expected time.Duration) CheckFunc {
+	return func(ctx context.Context) error {
+		fnCtx, cancel := context.WithTimeout(ctx, expected)
+		defer cancel()
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+
+			_ = callCheck(fnCtx, fn)
+		}()
+
+		select {
+		case <-done:
+			return nil
+		case <-time.After(2 * expected):
+			return fmt.Errorf("check ignored its %s deadline and was still running after %s", expected, 2*expected)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCheckRespectsDeadline(t *testing.T) {
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+
+	tests := map[string]struct {
+		fn      CheckFunc
+		wantErr bool
+	}{
+		"returns in time": {fn: checkFunc(nil)},
+		"fails in time":   {fn: checkFunc(errors.New("down"))},
+		"honors the deadline": {fn: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+		"panics": {fn: func(context.Context) error { panic("boom") }},
+		"ignores the deadline": {
+			fn: func(context.Context) error {
+				<-release
+				return nil
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := CheckRespectsDeadline(tt.fn, 10*time.Millisecond)(context.Background())
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	t.Run("parent context done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := CheckRespectsDeadline(func(context.Context) error {
+			<-release
+			return nil
+		}, time.Hour)(ctx)
+
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected the parent context error, got %v", err)
+		}
+	})
+}
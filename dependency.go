@@ -0,0 +1,34 @@
+package health
+
+// Failures are reported under the dependency key of their check, so a key may not be the name of a check. The checks
+// using a key are counted in the shard the key hashes to, where a check named after it would be registered:
+// registering a check without a dependency key only locks the shard of its name, registering one with a key also
+// locks the shard of the key.
+
+// addKey records a check with the dependency key. mu must be held for writing.
+func (sh *shard) addKey(key string) {
+	sh.keys[key]++
+}
+
+// removeKey forgets a check with the dependency key. mu must be held for writing.
+func (sh *shard) removeKey(key string) {
+	sh.keys[key]--
+	if sh.keys[key] <= 0 {
+		delete(sh.keys, key)
+	}
+}
+
+// deregisterWithKey removes the check with the name if its dependency key is key, reporting whether it did.
+func (h *Health) deregisterWithKey(name, key string) bool {
+	defer h.lockShards(name, key)()
+
+	sh := h.shard(name)
+	if c, ok := sh.checks[name]; !ok || c.DependencyKey != key {
+		return false
+	}
+
+	delete(sh.checks, name)
+	h.shard(key).removeKey(key)
+
+	return true
+}
@@ -0,0 +1,150 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// codedError is a CodedError with the given code and message.
+type codedError struct {
+	code, msg string
+}
+
+func (e codedError) Error() string { return e.msg }
+func (e codedError) Code() string  { return e.code }
+
+func TestDependencyKeyCollapse(t *testing.T) {
+	h := newHealth(t, WithChecks(
+		Check{Name: "db-read", DependencyKey: "postgres", Check: checkFunc(codedError{"ECONNREFUSED", "refused"})},
+		Check{Name: "db-write", DependencyKey: "postgres", Check: checkFunc(errors.New("read-only"))},
+		Check{Name: "cache", SkipOnErr: true, Check: checkFunc(codedError{"EAUTH", "denied"})},
+		Check{Name: "queue", DependencyKey: "rabbitmq", Check: checkFunc(nil)},
+	))
+
+	r := h.Check(context.Background())
+
+	wantFailures := map[string]string{
+		"postgres": "db-read: refused; db-write: read-only",
+		"cache":    "denied",
+	}
+	if len(r.Failures) != len(wantFailures) {
+		t.Errorf("expected failures %v, got %v", wantFailures, r.Failures)
+	}
+	for k, v := range wantFailures {
+		if r.Failures[k] != v {
+			t.Errorf("expected failure %q to be %q, got %q", k, v, r.Failures[k])
+		}
+	}
+
+	wantCodes := map[string]string{
+		"postgres": "db-read: ECONNREFUSED",
+		"cache":    "EAUTH",
+	}
+	if len(r.FailureCodes) != len(wantCodes) {
+		t.Errorf("expected failure codes %v, got %v", wantCodes, r.FailureCodes)
+	}
+	for k, v := range wantCodes {
+		if r.FailureCodes[k] != v {
+			t.Errorf("expected failure code %q to be %q, got %q", k, v, r.FailureCodes[k])
+		}
+	}
+
+	if _, ok := r.Failures["rabbitmq"]; ok {
+		t.Error("expected no failure for a dependency whose checks passed")
+	}
+	if r.WorstCheck != "postgres" {
+		t.Errorf("expected the worst check to be reported by dependency key, got %q", r.WorstCheck)
+	}
+}
+
+func TestDependencyKeyCollision(t *testing.T) {
+	tests := map[string]struct {
+		registered Check
+		check      Check
+		wantErr    bool
+	}{
+		"key is the name of a check": {
+			registered: Check{Name: "postgres"},
+			check:      Check{Name: "db-read", DependencyKey: "postgres"},
+			wantErr:    true,
+		},
+		"name is a dependency key": {
+			registered: Check{Name: "db-read", DependencyKey: "postgres"},
+			check:      Check{Name: "postgres"},
+			wantErr:    true,
+		},
+		"key is its own name": {
+			registered: Check{Name: "cache"},
+			check:      Check{Name: "postgres", DependencyKey: "postgres"},
+			wantErr:    true,
+		},
+		"shared key": {
+			registered: Check{Name: "db-read", DependencyKey: "postgres"},
+			check:      Check{Name: "db-write", DependencyKey: "postgres"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt.registered.Check, tt.check.Check = checkFunc(nil), checkFunc(nil)
+			h := newHealth(t, WithChecks(tt.registered))
+
+			if err := h.Register(tt.check); (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	t.Run("key released on deregistration", func(t *testing.T) {
+		h := newHealth(t, WithChecks(
+			Check{Name: "db-read", DependencyKey: "postgres", Check: checkFunc(nil)},
+			Check{Name: "db-write", DependencyKey: "postgres", Check: checkFunc(nil)},
+		))
+		postgres := Check{Name: "postgres", Check: checkFunc(nil)}
+
+		if err := h.Deregister("db-read"); err != nil {
+			t.Fatalf("could not deregister check: %v", err)
+		}
+		if err := h.Register(postgres); err == nil {
+			t.Fatal("expected the key to be in use while a check has it")
+		}
+
+		if err := h.Deregister("db-write"); err != nil {
+			t.Fatalf("could not deregister check: %v", err)
+		}
+		if err := h.Register(postgres); err != nil {
+			t.Errorf("expected the key to be released, got %v", err)
+		}
+	})
+}
+
+func TestDependencyKeyCollisionConcurrent(t *testing.T) {
+	for i := 0; i < 200; i++ {
+		h := newHealth(t)
+
+		var wg sync.WaitGroup
+		var registered atomic.Int32
+		for _, c := range []Check{
+			{Name: "postgres", Check: checkFunc(nil)},
+			{Name: "db-read", DependencyKey: "postgres", Check: checkFunc(nil)},
+		} {
+			wg.Add(1)
+
+			go func(c Check) {
+				defer wg.Done()
+
+				if h.Register(c) == nil {
+					registered.Add(1)
+				}
+			}(c)
+		}
+		wg.Wait()
+
+		if n := registered.Load(); n != 1 {
+			t.Fatalf("expected either the check or the dependency key to be registered, got %d registrations", n)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package health
+
+import (
+	"context"
+	"fmt"
+)
+
+// Fallback creates a check which performs primary and, if it fails, secondary. It succeeds if either
+// succeeds and otherwise fails with both errors. secondary is not performed once ctx is done.
+func Fallback(primary, secondary CheckFunc) CheckFunc {
+	return func(ctx context.Context) error {
+		err := primary(ctx)
+		if err == nil {
+			return nil
+		}
+
+		if ctx.Err() != nil {
+			return fmt.Errorf("primary: %w", err)
+		}
+
+		if err2 := secondary(ctx); err2 != nil {
+			return fmt.Errorf("primary: %v; secondary: %w", err, err2)
+		}
+
+		return nil
+	}
+}
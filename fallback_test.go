@@ -0,0 +1,54 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestFallback(t *testing.T) {
+	errPrimary, errSecondary := errors.New("primary down"), errors.New("secondary down")
+
+	tests := map[string]struct {
+		primary, secondary error
+		wantSecondaryCall  bool
+		wantErr            error
+	}{
+		"primary up":   {},
+		"secondary up": {primary: errPrimary, wantSecondaryCall: true},
+		"both down":    {primary: errPrimary, secondary: errSecondary, wantSecondaryCall: true, wantErr: errSecondary},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			secondaryCalled := false
+			check := Fallback(checkFunc(tt.primary), func(context.Context) error {
+				secondaryCalled = true
+				return tt.secondary
+			})
+
+			err := check(context.Background())
+			if !errors.Is(err, tt.wantErr) || (err == nil) != (tt.wantErr == nil) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if secondaryCalled != tt.wantSecondaryCall {
+				t.Errorf("expected the secondary to be called %t, got %t", tt.wantSecondaryCall, secondaryCalled)
+			}
+		})
+	}
+
+	t.Run("context done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		secondaryCalled := false
+		err := Fallback(checkFunc(errPrimary), func(context.Context) error {
+			secondaryCalled = true
+			return nil
+		})(ctx)
+
+		if !errors.Is(err, errPrimary) || secondaryCalled {
+			t.Errorf("expected the primary error without calling the secondary, got %v", err)
+		}
+	})
+}
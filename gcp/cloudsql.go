@@ -0,0 +1,37 @@
+// Package gcp provides health checks for Google Cloud services.
+//
+// The checks depend on small client interfaces instead of the Google Cloud client libraries, so this module
+// does not pull them in. Each interface is implemented by a thin adapter around the matching client.
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// CloudSQLClient is the part of the Cloud SQL Admin API used by NewCloudSQLCheck.
+type CloudSQLClient interface {
+	// GetInstance returns the state of the instance, as returned by the instances.get API.
+	GetInstance(ctx context.Context, projectID, instanceID string) (string, error)
+}
+
+// NewCloudSQLCheck creates a check which verifies the Cloud SQL instance state is RUNNABLE.
+func NewCloudSQLCheck(name string, service CloudSQLClient, projectID, instanceID string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			state, err := service.GetInstance(ctx, projectID, instanceID)
+			if err != nil {
+				return fmt.Errorf("could not get instance %q: %w", instanceID, err)
+			}
+
+			if state != "RUNNABLE" {
+				return fmt.Errorf("instance %q is %s", instanceID, state)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package gcp
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type fakeCloudSQL struct {
+	state string
+	err   error
+}
+
+func (f fakeCloudSQL) GetInstance(_ context.Context, projectID, instanceID string) (string, error) {
+	return f.state, f.err
+}
+
+func TestNewCloudSQLCheck(t *testing.T) {
+	tests := map[string]struct {
+		client  fakeCloudSQL
+		wantErr bool
+	}{
+		"runnable":           {client: fakeCloudSQL{state: "RUNNABLE"}},
+		"maintenance":        {client: fakeCloudSQL{state: "MAINTENANCE"}, wantErr: true},
+		"suspended":          {client: fakeCloudSQL{state: "SUSPENDED"}, wantErr: true},
+		"instance not found": {client: fakeCloudSQL{err: errors.New("notFound")}, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := NewCloudSQLCheck("cloudsql", tt.client, "project", "db").Check(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// MemorystoreClient is the part of the Memorystore for Redis API used by NewMemorystoreCheck.
+type MemorystoreClient interface {
+	// GetInstance returns the state of the instance with the given resource name,
+	// projects/{project}/locations/{location}/instances/{instance}, as returned by the instances.get API.
+	GetInstance(ctx context.Context, name string) (string, error)
+}
+
+// NewMemorystoreCheck creates a check which verifies the Memorystore Redis instance state is READY.
+func NewMemorystoreCheck(name string, client MemorystoreClient, projectID, location, instanceID string) health.Check {
+	return health.Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			resource := fmt.Sprintf("projects/%s/locations/%s/instances/%s", projectID, location, instanceID)
+
+			state, err := client.GetInstance(ctx, resource)
+			if err != nil {
+				return fmt.Errorf("could not get instance %q: %w", resource, err)
+			}
+
+			if state != "READY" {
+				return fmt.Errorf("instance %q is %s", resource, state)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package gcp
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type fakeMemorystore struct {
+	state string
+	err   error
+	name  string
+}
+
+func (f *fakeMemorystore) GetInstance(_ context.Context, name string) (string, error) {
+	f.name = name
+	return f.state, f.err
+}
+
+func TestNewMemorystoreCheck(t *testing.T) {
+	tests := map[string]struct {
+		client  *fakeMemorystore
+		wantErr bool
+	}{
+		"ready":              {client: &fakeMemorystore{state: "READY"}},
+		"updating":           {client: &fakeMemorystore{state: "UPDATING"}, wantErr: true},
+		"repairing":          {client: &fakeMemorystore{state: "REPAIRING"}, wantErr: true},
+		"instance not found": {client: &fakeMemorystore{err: errors.New("notFound")}, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := NewMemorystoreCheck("memorystore", tt.client, "project", "europe-west1", "cache").Check(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+
+			if want := "projects/project/locations/europe-west1/instances/cache"; tt.client.name != want {
+				t.Errorf("expected instance %q, got %q", want, tt.client.name)
+			}
+		})
+	}
+}
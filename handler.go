@@ -0,0 +1,86 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// uncategorized is the category of checks without one.
+const uncategorized = "uncategorized"
+
+// CategoryHandler returns a handler performing the checks and responding with their results as JSON,
+// grouped by category. It responds with 503 unless the status is OK.
+func (h *Health) CategoryHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		result := h.Check(r.Context())
+
+		categories := make(map[string]map[string]CheckResult)
+		for name, c := range result.Checks {
+			category := c.Category
+			if category == "" {
+				category = uncategorized
+			}
+
+			if categories[category] == nil {
+				categories[category] = make(map[string]CheckResult)
+			}
+			categories[category][name] = c
+		}
+
+		writeJSON(w, result.Status, struct {
+			Status     Status                            `json:"status"`
+			Timestamp  time.Time                         `json:"timestamp"`
+			Categories map[string]map[string]CheckResult `json:"categories"`
+		}{result.Status, result.Timestamp, categories})
+	})
+}
+
+// Handler returns a handler performing the checks and responding with the result as JSON. It responds with
+// 503 unless the status is OK. With the debug=1 query parameter, every check result includes the check
+// configuration as returned by Config: its timeout, whether it is critical, and its Category, which is how checks
+// are grouped. Checks carry no labels, so none are reported.
+func (h *Health) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		result := h.Check(r.Context())
+
+		if r.URL.Query().Get("debug") != "1" {
+			writeJSON(w, result.Status, result)
+			return
+		}
+
+		type debugCheck struct {
+			CheckResult
+			Config *CheckConfig `json:"config,omitempty"`
+		}
+
+		configs := make(map[string]CheckConfig)
+		for _, c := range h.Config() {
+			configs[c.Name] = c
+		}
+
+		checks := make(map[string]debugCheck, len(result.Checks))
+		for name, c := range result.Checks {
+			dc := debugCheck{CheckResult: c}
+			if config, ok := configs[name]; ok {
+				dc.Config = &config
+			}
+			checks[name] = dc
+		}
+
+		writeJSON(w, result.Status, struct {
+			Result
+			Checks map[string]debugCheck `json:"checks,omitempty"`
+		}{result, checks})
+	})
+}
+
+// writeJSON writes v as JSON with 200 if status is OK, 503 otherwise.
+func writeJSON(w http.ResponseWriter, status Status, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if status != StatusOK {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,132 @@
+package health
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// serve performs a GET request to the handler and returns the response.
+func serve(t *testing.T, handler http.Handler, target string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+
+	if ct := w.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("expected a JSON response, got %q", ct)
+	}
+
+	return w
+}
+
+func TestCategoryHandler(t *testing.T) {
+	h := newHealth(t, WithChecks(
+		Check{Name: "postgres", Category: "storage", Check: checkFunc(nil)},
+		Check{Name: "s3", Category: "storage", Check: checkFunc(errors.New("down"))},
+		Check{Name: "kafka", Category: "messaging", Check: checkFunc(nil)},
+		Check{Name: "clock", Check: checkFunc(nil)},
+	))
+
+	w := serve(t, h.CategoryHandler(), "/health/categories")
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected status code %d, got %d", http.StatusServiceUnavailable, w.Code)
+	}
+
+	var body struct {
+		Status     Status                            `json:"status"`
+		Categories map[string]map[string]CheckResult `json:"categories"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+
+	if body.Status != StatusUnavailable {
+		t.Errorf("expected %q, got %q", StatusUnavailable, body.Status)
+	}
+
+	want := map[string][]string{
+		"storage":     {"postgres", "s3"},
+		"messaging":   {"kafka"},
+		uncategorized: {"clock"},
+	}
+	for category, names := range want {
+		if len(body.Categories[category]) != len(names) {
+			t.Errorf("expected %d checks in %q, got %v", len(names), category, body.Categories[category])
+		}
+		for _, n := range names {
+			if _, ok := body.Categories[category][n]; !ok {
+				t.Errorf("expected %q in category %q", n, category)
+			}
+		}
+	}
+
+	if got := body.Categories["storage"]["s3"].Status; got != StatusUnavailable {
+		t.Errorf("expected s3 to be %q, got %q", StatusUnavailable, got)
+	}
+}
+
+func TestHandlerDebug(t *testing.T) {
+	h := newHealth(t, WithChecks(
+		Check{Name: "db", Description: "Primary database.", Category: "storage", Check: checkFunc(nil)},
+		Check{Name: "cache", SkipOnErr: true, Check: checkFunc(errors.New("down"))},
+	))
+
+	type body struct {
+		Status Status `json:"status"`
+		Checks map[string]struct {
+			Status Status       `json:"status"`
+			Config *CheckConfig `json:"config"`
+		} `json:"checks"`
+	}
+
+	t.Run("debug includes the config", func(t *testing.T) {
+		w := serve(t, h.Handler(), "/health?debug=1")
+
+		var b body
+		if err := json.Unmarshal(w.Body.Bytes(), &b); err != nil {
+			t.Fatalf("could not decode the response: %v", err)
+		}
+
+		if b.Status != StatusPartiallyAvailable || len(b.Checks) != 2 {
+			t.Fatalf("expected the result of both checks, got %+v", b)
+		}
+
+		db := b.Checks["db"]
+		if db.Status != StatusOK || db.Config == nil {
+			t.Fatalf("expected the db result with its config, got %+v", db)
+		}
+		if db.Config.Description != "Primary database." || db.Config.Category != "storage" || !db.Config.Critical {
+			t.Errorf("unexpected db config %+v", *db.Config)
+		}
+		if c := b.Checks["cache"].Config; c == nil || !c.SkipOnErr || c.Timeout == 0 {
+			t.Errorf("unexpected cache config %+v", c)
+		}
+	})
+
+	for name, target := range map[string]string{
+		"no debug":    "/health",
+		"debug off":   "/health?debug=0",
+		"debug other": "/health?debug=true",
+	} {
+		t.Run(name+" omits the config", func(t *testing.T) {
+			w := serve(t, h.Handler(), target)
+
+			var b body
+			if err := json.Unmarshal(w.Body.Bytes(), &b); err != nil {
+				t.Fatalf("could not decode the response: %v", err)
+			}
+
+			if len(b.Checks) != 2 {
+				t.Fatalf("expected the result of both checks, got %+v", b)
+			}
+			for name, c := range b.Checks {
+				if c.Config != nil {
+					t.Errorf("expected no config for %q, got %+v", name, *c.Config)
+				}
+			}
+		})
+	}
+}
@@ -5,7 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"runtime"
+	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -34,6 +37,13 @@ type (
 		AllocBytes int `json:"alloc_bytes"`
 	}
 
+	// CodedError is implemented by check errors carrying a machine-readable reason code,
+	// e.g. "connection-refused" or "auth".
+	CodedError interface {
+		error
+		Code() string
+	}
+
 	// CheckFunc is the func which executes the check.
 	CheckFunc func(context.Context) error
 
@@ -42,6 +52,17 @@ type (
 		Timeout   time.Duration
 		SkipOnErr bool
 		Check     CheckFunc
+		// Category groups related checks, e.g. "storage" or "messaging".
+		Category string
+		// DependencyKey identifies the dependency the check targets. Failures of checks sharing a key
+		// are reported as a single failure under that key, in Failures, FailureCodes and WorstCheck.
+		// It cannot be the name of a registered check.
+		DependencyKey string
+		// Description explains what the check verifies, for operators. It does not affect execution.
+		Description string
+		// Quick is an optional cheap check performed first. Check is only performed when Quick
+		// returns ErrInconclusive.
+		Quick CheckFunc
 	}
 
 	Result struct {
@@ -49,35 +70,95 @@ type (
 		Status Status `json:"status"`
 		// Timestamp is the time in which the check occurred.
 		Timestamp time.Time `json:"timestamp"`
-		// Failures holds the failed checks along with their messages.
+		// Failures holds the failed checks, or their dependency key, along with their messages.
 		Failures map[string]string `json:"failures,omitempty"`
+		// FailureCodes holds the failed checks returning a CodedError, or their dependency key, along with their code.
+		// Timed out checks have the "timeout" code.
+		FailureCodes map[string]string `json:"failure_codes,omitempty"`
+		// Checks holds the outcome of every performed check.
+		Checks map[string]CheckResult `json:"checks,omitempty"`
+		// WorstCheck names the failed check which most influenced the status, or its dependency key: failures making
+		// the component unavailable come before the others, then the slowest failure, then the first name in
+		// lexical order.
+		WorstCheck string `json:"worst_check,omitempty"`
+		// OrphanedChecks is the number of checks which timed out, in this or previous runs, and are still running.
+		OrphanedChecks int `json:"orphaned_checks"`
 		// System holds information of the go process.
 		*System `json:"system,omitempty"`
 		// Component holds information on the component for which checks are made
 		Component `json:"component"`
 	}
 
+	// CheckResult is the outcome of a single check.
+	CheckResult struct {
+		// Status is the check status.
+		Status Status `json:"status"`
+		// Duration is how long the check took, or its timeout if it timed out.
+		Duration time.Duration `json:"duration"`
+		// Error is the check failure message.
+		Error string `json:"error,omitempty"`
+		// Category is the category of the check.
+		Category string `json:"category,omitempty"`
+	}
+
 	Health struct {
-		mu            sync.Mutex
-		checks        map[string]Check
-		maxConcurrent int
-		systemInfo    bool
-		component     Component
+		mu              sync.Mutex
+		shards          []shard
+		maxConcurrent   int
+		systemInfo      bool
+		component       Component
+		skippedStatus   Status
+		last            *Result
+		transform       func(Result) Result
+		normalize       func(string) string
+		strategy        ExecutionStrategy
+		cacheTTL        time.Duration
+		cache           map[string]Result
+		staleAfter      time.Duration
+		refreshing      map[string]bool
+		orphans         atomic.Int64
+		logger          Logger
+		statusLogLevels map[Status]Level
+		history         history
+		hysteresis      hysteresis
+		subscribers     subscribers
+		workers         workers
 	}
 )
 
+var errTimeout = errors.New("Timeout")
+
+// minTimeout is the smallest check timeout accepted on registration, below it checks would time out
+// before having a chance to run.
+const minTimeout = time.Millisecond
+
+// ErrInconclusive is returned by a Quick check which cannot tell whether the component is healthy.
+var ErrInconclusive = errors.New("inconclusive")
+
+// ErrDegraded marks a check failure as a degradation. Wrapping it makes the failure yield
+// Partially Available instead of Unavailable, as SkipOnErr does.
+var ErrDegraded = errors.New("degraded")
+
 const (
 	StatusOK                 Status = "OK"
 	StatusPartiallyAvailable Status = "Partially Available"
 	StatusUnavailable        Status = "Unavailable"
 	StatusTimeout            Status = "Timeout during health check"
+	StatusUnknown            Status = "Unknown"
 )
 
 func NewHealth(opts ...Option) (*Health, error) {
 	h := &Health{
-		checks:        make(map[string]Check),
 		maxConcurrent: runtime.NumCPU(),
 		systemInfo:    true,
+		cache:         make(map[string]Result),
+		refreshing:    make(map[string]bool),
+	}
+
+	h.shards = make([]shard, shardCount)
+	for i := range h.shards {
+		h.shards[i].checks = make(map[string]Check)
+		h.shards[i].keys = make(map[string]int)
 	}
 
 	for _, o := range opts {
@@ -96,87 +177,265 @@ func (h *Health) Register(c Check) error {
 		c.Timeout = time.Second * 2
 	}
 
+	if c.Timeout < minTimeout {
+		return fmt.Errorf("health check %q timeout must be at least %s", c.Name, minTimeout)
+	}
+
+	c.Name = h.normalizeName(c.Name)
+
 	if c.Name == "" {
 		return errors.New("health check must have a name to be registered")
 	}
 
-	h.mu.Lock()
-	defer h.mu.Unlock()
+	if c.DependencyKey == c.Name {
+		return fmt.Errorf("dependency key %q of health check %q is the name of a check", c.DependencyKey, c.Name)
+	}
+
+	sh := h.shard(c.Name)
+	if c.DependencyKey == "" {
+		sh.mu.Lock()
+		defer sh.mu.Unlock()
+	} else {
+		defer h.lockShards(c.Name, c.DependencyKey)()
+	}
+
+	if sh.keys[c.Name] > 0 {
+		return fmt.Errorf("health check name %q is already used as a dependency key", c.Name)
+	}
 
-	if _, ok := h.checks[c.Name]; ok {
+	if _, ok := sh.checks[c.Name]; ok {
 		return fmt.Errorf("health check %q is already registered", c.Name)
 	}
 
-	h.checks[c.Name] = c
+	if c.DependencyKey != "" {
+		ksh := h.shard(c.DependencyKey)
+		if _, ok := ksh.checks[c.DependencyKey]; ok {
+			return fmt.Errorf("dependency key %q of health check %q is the name of a check", c.DependencyKey, c.Name)
+		}
+
+		ksh.addKey(c.DependencyKey)
+	}
+
+	sh.checks[c.Name] = c
 
 	return nil
 }
 
+// Deregister removes a registered check so it is no longer performed.
+func (h *Health) Deregister(name string) error {
+	name = h.normalizeName(name)
+	sh := h.shard(name)
+
+	for {
+		sh.mu.Lock()
+		c, ok := sh.checks[name]
+		if ok && c.DependencyKey == "" {
+			delete(sh.checks, name)
+		}
+		sh.mu.Unlock()
+
+		if !ok {
+			return fmt.Errorf("health check %q is not registered", name)
+		}
+
+		// a check with a dependency key is looked up again with the shard of its key locked as well,
+		// in case it was replaced in between
+		if c.DependencyKey == "" || h.deregisterWithKey(name, c.DependencyKey) {
+			return nil
+		}
+	}
+}
+
+// Check performs the registered checks and returns the aggregated result.
+// ctx is handed to every check, so a span started by the caller, e.g. the handler serving the probe,
+// is the parent of the spans started by the checks and shares their trace ID.
 func (h *Health) Check(ctx context.Context) Result {
-	h.mu.Lock()
-	defer h.mu.Unlock()
+	return h.check(ctx, nil)
+}
 
-	status := StatusOK
-	failures := make(map[string]string)
+// CheckFiltered performs the registered checks with the given names and returns the aggregated result.
+// Unknown names are ignored, no name performs every check.
+func (h *Health) CheckFiltered(ctx context.Context, names ...string) Result {
+	return h.check(ctx, names)
+}
 
-	limiterCh := make(chan bool, h.maxConcurrent)
-	defer close(limiterCh)
+// check returns the result of the checks with the given names, every check if there are none,
+// from the cache if possible.
+func (h *Health) check(ctx context.Context, names []string) Result {
+	key := h.selector(names)
+	// the cached results and their background refreshes use the timeouts of the checks
+	cacheable := !timeoutScaled(ctx)
+
+	var result Result
+	var ok bool
+	if cacheable {
+		h.mu.Lock()
+		result, ok = h.cached(key, names)
+		h.mu.Unlock()
+	}
 
-	var (
-		wg sync.WaitGroup
-		mu sync.Mutex
-	)
+	if !ok {
+		result = h.run(ctx, h.selected(names))
 
-	for _, c := range h.checks {
-		limiterCh <- true
-		wg.Add(1)
+		h.mu.Lock()
+		result = h.store(key, result, cacheable)
+		h.mu.Unlock()
 
-		go func(c Check) {
-			defer func() {
-				<-limiterCh
-				wg.Done()
-			}()
+		h.logRun(result)
 
-			resCh := make(chan error)
+		if key == "" {
+			h.publish(result)
+		}
+	}
 
-			go func() {
-				resCh <- c.Check(ctx)
-				defer close(resCh)
-			}()
+	return h.output(result)
+}
 
-			select {
-			case <-time.After(c.Timeout):
-				mu.Lock()
-				defer mu.Unlock()
+// run performs the checks and aggregates their outcome.
+func (h *Health) run(ctx context.Context, checks []Check) Result {
+	status := h.statusWhenSkipped()
+	if len(checks) > 0 {
+		status = StatusOK
+	}
+	failures := make(map[string]string)
+	codes := make(map[string]string)
+	results := make(map[string]CheckResult, len(checks))
+	critical := make(map[string]bool)
+	dependencies := make(map[string]string)
+
+	var mu sync.Mutex
+
+	// record records the outcome of the check, err being its failure if any. mu must be held.
+	record := func(c Check, res CheckResult, err error) {
+		if err != nil {
+			degraded := errors.Is(err, ErrDegraded)
+
+			res.Status = StatusUnavailable
+			if err == errTimeout {
+				res.Status = StatusTimeout
+			} else if degraded {
+				res.Status = StatusPartiallyAvailable
+			}
+			res.Error = err.Error()
 
-				failures[c.Name] = "Timeout"
-				status = getAvailability(status, c.SkipOnErr)
-			case res := <-resCh:
+			failures[c.Name] = err.Error()
+			if code := failureCode(err); code != "" {
+				codes[c.Name] = code
+			}
+			critical[c.Name] = !c.SkipOnErr && !degraded
+			dependencies[c.Name] = c.DependencyKey
+			status = getAvailability(status, c.SkipOnErr || degraded)
+		}
+
+		results[c.Name] = res
+	}
+
+	h.execute(checks, func(c Check) {
+		start := time.Now()
+
+		// recording the failure calls into the error, which may panic as well
+		defer func() {
+			if r := recover(); r != nil {
 				mu.Lock()
 				defer mu.Unlock()
 
-				if res != nil {
-					failures[c.Name] = res.Error()
-					status = getAvailability(status, c.SkipOnErr)
-				}
+				res := CheckResult{Status: StatusOK, Duration: time.Since(start), Category: c.Category}
+				record(c, res, fmt.Errorf("panic: %v", r))
 			}
-		}(c)
-	}
+		}()
 
-	wg.Wait()
+		err := h.runCheck(ctx, c)
+		res := CheckResult{Status: StatusOK, Duration: time.Since(start), Category: c.Category}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		record(c, res, err)
+	})
 
 	var systemMetrics *System
 	if h.systemInfo {
 		systemMetrics = newSystemMetrics()
 	}
 
-	return Result{
-		Status:    status,
-		Failures:  failures,
-		System:    systemMetrics,
-		Component: h.component,
-		Timestamp: time.Now(),
+	worst := worstCheck(results, critical)
+	if key := dependencies[worst]; key != "" {
+		worst = key
+	}
+
+	result := Result{
+		Status:         status,
+		Failures:       collapseFailures(failures, dependencies),
+		FailureCodes:   collapseFailures(codes, dependencies),
+		Checks:         results,
+		WorstCheck:     worst,
+		OrphanedChecks: int(h.orphans.Load()),
+		System:         systemMetrics,
+		Component:      h.component,
+		Timestamp:      time.Now(),
+	}
+
+	return result
+}
+
+// LastResult returns the result of the last run, or false if the checks never ran. Checks registered since
+// the last run are reported with StatusUnknown.
+func (h *Health) LastResult() (Result, bool) {
+	h.mu.Lock()
+	last := h.last
+	h.mu.Unlock()
+
+	if last == nil {
+		return Result{}, false
+	}
+
+	return h.output(h.withUnknown(*last)), true
+}
+
+// withUnknown returns a copy of r in which the registered checks it has no outcome for have StatusUnknown.
+func (h *Health) withUnknown(r Result) Result {
+	checks := make(map[string]CheckResult, len(r.Checks))
+	for name, c := range r.Checks {
+		checks[name] = c
+	}
+
+	for _, c := range h.registered() {
+		if _, ok := checks[c.Name]; !ok {
+			checks[c.Name] = CheckResult{Status: StatusUnknown, Category: c.Category}
+		}
 	}
+
+	r.Checks = checks
+
+	return r
+}
+
+// statusWhenSkipped returns the status of a run in which no check was executed:
+// the configured skipped status, else the last known status, else OK.
+func (h *Health) statusWhenSkipped() Status {
+	if h.skippedStatus != "" {
+		return h.skippedStatus
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.last != nil {
+		return h.last.Status
+	}
+
+	return StatusOK
+}
+
+// perform performs the quick check, then the full check if the quick one was inconclusive.
+func (c Check) perform(ctx context.Context) error {
+	if c.Quick != nil {
+		if err := c.Quick(ctx); !errors.Is(err, ErrInconclusive) {
+			return err
+		}
+	}
+
+	return c.Check(ctx)
 }
 
 func newSystemMetrics() *System {
@@ -192,6 +451,72 @@ func newSystemMetrics() *System {
 	}
 }
 
+// failureCode returns the reason code of a check error, if it has one.
+func failureCode(err error) string {
+	if err == errTimeout {
+		return "timeout"
+	}
+
+	var coded CodedError
+	if errors.As(err, &coded) {
+		return coded.Code()
+	}
+
+	return ""
+}
+
+// collapseFailures replaces the entries of failures, e.g. the messages or the codes of the failed checks, of checks
+// sharing a dependency key, dependencies holding the key of every failed check, with a single entry under that key
+// listing each check and its value.
+func collapseFailures(failures map[string]string, dependencies map[string]string) map[string]string {
+	grouped := make(map[string][]string)
+	for name, key := range dependencies {
+		if _, ok := failures[name]; ok && key != "" {
+			grouped[key] = append(grouped[key], name)
+		}
+	}
+
+	for key, names := range grouped {
+		sort.Strings(names)
+
+		details := make([]string, 0, len(names))
+		for _, name := range names {
+			details = append(details, name+": "+failures[name])
+			delete(failures, name)
+		}
+
+		failures[key] = strings.Join(details, "; ")
+	}
+
+	return failures
+}
+
+// worstCheck returns the name of the worst failed check, critical holding the failed checks along with
+// whether their failure makes the component unavailable.
+func worstCheck(results map[string]CheckResult, critical map[string]bool) string {
+	worst := ""
+	for name, isCritical := range critical {
+		if worst == "" {
+			worst = name
+			continue
+		}
+
+		if isCritical != critical[worst] {
+			if isCritical {
+				worst = name
+			}
+			continue
+		}
+
+		d, wd := results[name].Duration, results[worst].Duration
+		if d > wd || d == wd && name < worst {
+			worst = name
+		}
+	}
+
+	return worst
+}
+
 func getAvailability(s Status, skipOnErr bool) Status {
 	if skipOnErr && s != StatusUnavailable {
 		return StatusPartiallyAvailable
@@ -0,0 +1,197 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// checkFunc returns a check func returning err.
+func checkFunc(err error) CheckFunc {
+	return func(context.Context) error { return err }
+}
+
+// newHealth returns a Health built with opts, failing the test on error.
+func newHealth(t testing.TB, opts ...Option) *Health {
+	t.Helper()
+
+	h, err := NewHealth(opts...)
+	if err != nil {
+		t.Fatalf("could not create health: %v", err)
+	}
+	t.Cleanup(h.Close)
+
+	return h
+}
+
+func TestSkippedStatus(t *testing.T) {
+	t.Run("defaults to OK", func(t *testing.T) {
+		h := newHealth(t)
+
+		if s := h.Check(context.Background()).Status; s != StatusOK {
+			t.Errorf("expected %q, got %q", StatusOK, s)
+		}
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		h := newHealth(t, WithSkippedStatus(StatusPartiallyAvailable))
+
+		if s := h.Check(context.Background()).Status; s != StatusPartiallyAvailable {
+			t.Errorf("expected %q, got %q", StatusPartiallyAvailable, s)
+		}
+	})
+
+	t.Run("reuses the last known status", func(t *testing.T) {
+		h := newHealth(t, WithChecks(Check{Name: "db", Check: checkFunc(errors.New("down"))}))
+		h.Check(context.Background())
+
+		if s := h.CheckFiltered(context.Background(), "unknown").Status; s != StatusUnavailable {
+			t.Errorf("expected %q, got %q", StatusUnavailable, s)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		if _, err := NewHealth(WithSkippedStatus("Sleepy")); err == nil {
+			t.Error("expected an error for an invalid status")
+		}
+	})
+}
+
+// panickingError is a CodedError whose Error or Code method panics.
+type panickingError struct {
+	inError bool
+}
+
+func (e panickingError) Error() string {
+	if e.inError {
+		panic("error message")
+	}
+	return "failed"
+}
+
+func (e panickingError) Code() string {
+	panic("error code")
+}
+
+func TestCheckPanic(t *testing.T) {
+	tests := map[string]struct {
+		fn          CheckFunc
+		skipOnErr   bool
+		wantFailure string
+		wantStatus  Status
+	}{
+		"in the check": {
+			fn:          func(context.Context) error { panic("boom") },
+			wantFailure: "panic: boom",
+			wantStatus:  StatusUnavailable,
+		},
+		"in the error message": {
+			fn:          checkFunc(panickingError{inError: true}),
+			wantFailure: "panic: error message",
+			wantStatus:  StatusUnavailable,
+		},
+		"in the error code": {
+			fn:          checkFunc(panickingError{}),
+			wantFailure: "panic: error code",
+			wantStatus:  StatusUnavailable,
+		},
+		"in the error code with SkipOnErr": {
+			fn:          checkFunc(panickingError{}),
+			skipOnErr:   true,
+			wantFailure: "panic: error code",
+			wantStatus:  StatusPartiallyAvailable,
+		},
+	}
+
+	strategies := map[string]ExecutionStrategy{"goroutine per check": GoroutinePerCheck, "worker pool": WorkerPool, "inline": Inline}
+
+	for name, tt := range tests {
+		for strategyName, strategy := range strategies {
+			t.Run(name+" "+strategyName, func(t *testing.T) {
+				h := newHealth(t, WithExecutionStrategy(strategy), WithChecks(
+					Check{Name: "panics", SkipOnErr: tt.skipOnErr, Check: tt.fn},
+					Check{Name: "db", Check: checkFunc(nil)},
+				))
+
+				result := h.Check(context.Background())
+
+				if result.Status != tt.wantStatus {
+					t.Errorf("expected %q, got %q", tt.wantStatus, result.Status)
+				}
+				if got := result.Failures["panics"]; got != tt.wantFailure {
+					t.Errorf("expected the panic to be reported, got %q", got)
+				}
+				if got := result.Checks["panics"]; got.Status != StatusUnavailable || got.Error != tt.wantFailure {
+					t.Errorf("expected the check to be %q, got %+v", StatusUnavailable, got)
+				}
+				if _, ok := result.FailureCodes["panics"]; ok {
+					t.Error("expected no failure code for a panic")
+				}
+				if got := result.Checks["db"].Status; got != StatusOK {
+					t.Errorf("expected the other check to be %q, got %q", StatusOK, got)
+				}
+			})
+		}
+	}
+}
+
+func TestLastResultUnknown(t *testing.T) {
+	h := newHealth(t, WithChecks(Check{Name: "db", Check: checkFunc(nil)}))
+
+	if _, ok := h.LastResult(); ok {
+		t.Fatal("expected no last result before the first run")
+	}
+
+	h.Check(context.Background())
+
+	if err := h.Register(Check{Name: "nightly", Category: "batch", Check: checkFunc(nil)}); err != nil {
+		t.Fatalf("could not register check: %v", err)
+	}
+
+	result, ok := h.LastResult()
+	if !ok {
+		t.Fatal("expected a last result")
+	}
+
+	if got := result.Checks["nightly"]; got.Status != StatusUnknown || got.Category != "batch" {
+		t.Errorf("expected the check which never ran to be %q, got %+v", StatusUnknown, got)
+	}
+	if got := result.Checks["db"].Status; got != StatusOK {
+		t.Errorf("expected the check which ran to be %q, got %q", StatusOK, got)
+	}
+	if result.Status != StatusOK {
+		t.Errorf("expected the unknown check not to affect the status, got %q", result.Status)
+	}
+
+	for _, c := range h.Config() {
+		want := StatusOK
+		if c.Name == "nightly" {
+			want = StatusUnknown
+		}
+
+		if c.LastStatus != want {
+			t.Errorf("expected the last status of %q to be %q, got %q", c.Name, want, c.LastStatus)
+		}
+	}
+
+	if got := h.Check(context.Background()).Checks["nightly"].Status; got != StatusOK {
+		t.Errorf("expected the check to be %q once run, got %q", StatusOK, got)
+	}
+}
+
+func TestDegraded(t *testing.T) {
+	h := newHealth(t, WithChecks(
+		Check{Name: "db", Check: checkFunc(nil)},
+		Check{Name: "search", Check: checkFunc(fmt.Errorf("%w: slow", ErrDegraded))},
+	))
+
+	result := h.Check(context.Background())
+
+	if result.Status != StatusPartiallyAvailable {
+		t.Errorf("expected %q, got %q", StatusPartiallyAvailable, result.Status)
+	}
+	if got := result.Checks["search"].Status; got != StatusPartiallyAvailable {
+		t.Errorf("expected the degraded check to be %q, got %q", StatusPartiallyAvailable, got)
+	}
+}
@@ -0,0 +1,87 @@
+package health
+
+// history is a ring buffer of the statuses of the last runs.
+type history struct {
+	statuses []Status
+	next     int
+	full     bool
+}
+
+// add records a status, overwriting the oldest one once the buffer is full.
+func (hs *history) add(s Status) {
+	if len(hs.statuses) == 0 {
+		return
+	}
+
+	hs.statuses[hs.next] = s
+	hs.next = (hs.next + 1) % len(hs.statuses)
+	if hs.next == 0 {
+		hs.full = true
+	}
+}
+
+// recorded returns the recorded statuses, oldest first.
+func (hs *history) recorded() []Status {
+	if !hs.full {
+		return hs.statuses[:hs.next]
+	}
+
+	return append(append([]Status(nil), hs.statuses[hs.next:]...), hs.statuses[:hs.next]...)
+}
+
+// Availability returns the fraction of the runs in the availability window whose status was OK,
+// or 0 if no run was recorded. Only runs of every check are recorded.
+func (h *Health) Availability() float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	statuses := h.history.recorded()
+	if len(statuses) == 0 {
+		return 0
+	}
+
+	ok := 0
+	for _, s := range statuses {
+		if s == StatusOK {
+			ok++
+		}
+	}
+
+	return float64(ok) / float64(len(statuses))
+}
+
+// hysteresis smooths the status of the runs: it changes from OK to a failing status only after down
+// consecutive failing runs, and back to OK only after up consecutive OK runs.
+type hysteresis struct {
+	up, down int
+	reported Status
+	streak   int
+}
+
+// apply records the status of a run and returns the status to report.
+func (hy *hysteresis) apply(s Status) Status {
+	if hy.up == 0 || hy.reported == "" {
+		hy.reported = s
+		return s
+	}
+
+	if (s == StatusOK) == (hy.reported == StatusOK) {
+		hy.reported = s
+		hy.streak = 0
+		return s
+	}
+
+	hy.streak++
+
+	n := hy.down
+	if s == StatusOK {
+		n = hy.up
+	}
+
+	if hy.streak >= n {
+		hy.reported = s
+		hy.streak = 0
+	}
+
+	return hy.reported
+}
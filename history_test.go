@@ -0,0 +1,137 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestHistory(t *testing.T) {
+	tests := map[string]struct {
+		size  int
+		added []Status
+		want  []Status
+	}{
+		"empty":   {size: 3, want: []Status{}},
+		"partial": {size: 3, added: []Status{StatusOK, StatusUnavailable}, want: []Status{StatusOK, StatusUnavailable}},
+		"full":    {size: 2, added: []Status{StatusOK, StatusUnavailable}, want: []Status{StatusOK, StatusUnavailable}},
+		"wrapped": {
+			size:  3,
+			added: []Status{StatusOK, StatusUnavailable, StatusTimeout, StatusPartiallyAvailable, StatusOK},
+			want:  []Status{StatusTimeout, StatusPartiallyAvailable, StatusOK},
+		},
+		"disabled": {size: 0, added: []Status{StatusOK}, want: []Status{}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			hs := history{statuses: make([]Status, tt.size)}
+			for _, s := range tt.added {
+				hs.add(s)
+			}
+
+			got := hs.recorded()
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("expected %v, got %v", tt.want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestAvailability(t *testing.T) {
+	var failing bool
+	h := newHealth(t, WithAvailabilityWindow(4), WithChecks(Check{Name: "db", Check: func(context.Context) error {
+		if failing {
+			return errors.New("down")
+		}
+		return nil
+	}}))
+	ctx := context.Background()
+
+	if got := h.Availability(); got != 0 {
+		t.Errorf("expected no availability before the first run, got %v", got)
+	}
+
+	for _, f := range []bool{false, true, false, false} {
+		failing = f
+		h.Check(ctx)
+	}
+	if got := h.Availability(); got != 0.75 {
+		t.Errorf("expected an availability of 0.75, got %v", got)
+	}
+
+	// the failing run falls out of the window
+	failing = false
+	h.Check(ctx)
+	h.Check(ctx)
+	if got := h.Availability(); got != 1 {
+		t.Errorf("expected an availability of 1, got %v", got)
+	}
+
+	failing = true
+	if got := h.CheckFiltered(ctx, "db"); got.Status != StatusUnavailable || h.Availability() != 1 {
+		t.Error("expected a run of a subset of the checks not to be recorded")
+	}
+}
+
+func TestWithAvailabilityWindowInvalid(t *testing.T) {
+	if _, err := NewHealth(WithAvailabilityWindow(0)); err == nil {
+		t.Error("expected an error for an empty window")
+	}
+}
+
+func TestHysteresis(t *testing.T) {
+	var failing bool
+	logger := &recordingLogger{}
+	h := newHealth(t, WithHysteresis(2, 2), WithLogger(logger), WithChecks(Check{Name: "db", Check: func(context.Context) error {
+		if failing {
+			return errors.New("down")
+		}
+		return nil
+	}}))
+
+	const ok, ko = StatusOK, StatusUnavailable
+	steps := []struct {
+		failing bool
+		want    Status
+	}{
+		{false, ok},
+		// alternating runs never reach the required streak
+		{true, ok}, {false, ok}, {true, ok},
+		{true, ko},
+		{false, ko}, {true, ko}, {false, ko},
+		{false, ok},
+	}
+
+	for i, step := range steps {
+		failing = step.failing
+		r := h.Check(context.Background())
+
+		if r.Status != step.want {
+			t.Errorf("run %d: expected status %q, got %q", i, step.want, r.Status)
+		}
+		if _, reported := r.Failures["db"]; reported != step.failing {
+			t.Errorf("run %d: expected the failure to be reported regardless of the status", i)
+		}
+
+		entries := logger.logged()
+		if got := entries[len(entries)-1].keysAndValues[1]; got != step.want {
+			t.Errorf("run %d: expected the smoothed status %q to be logged, got %q", i, step.want, got)
+		}
+	}
+
+	if n := len(logger.logged()); n != len(steps) {
+		t.Errorf("expected 1 log entry per run, got %d", n)
+	}
+}
+
+func TestWithHysteresisInvalid(t *testing.T) {
+	if _, err := NewHealth(WithHysteresis(0, 2)); err == nil {
+		t.Error("expected an error for an empty streak")
+	}
+}
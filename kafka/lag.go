@@ -0,0 +1,36 @@
+// Package kafka provides health checks for Kafka consumers.
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// CheckConsumerLag creates a check which fails when the lag of any partition returned by lagFn exceeds maxLag.
+// lagFn returns the lag of the consumer group keyed by partition, e.g. "topic/0".
+func CheckConsumerLag(lagFn func(ctx context.Context) (map[string]int64, error), maxLag int64) health.CheckFunc {
+	return func(ctx context.Context) error {
+		lags, err := lagFn(ctx)
+		if err != nil {
+			return fmt.Errorf("could not get consumer lag: %w", err)
+		}
+
+		var offending []string
+		for partition, lag := range lags {
+			if lag > maxLag {
+				offending = append(offending, fmt.Sprintf("%s=%d", partition, lag))
+			}
+		}
+
+		if len(offending) > 0 {
+			sort.Strings(offending)
+			return fmt.Errorf("consumer lag exceeds %d on partitions %s", maxLag, strings.Join(offending, ", "))
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,31 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestCheckConsumerLag(t *testing.T) {
+	tests := map[string]struct {
+		lags    map[string]int64
+		err     error
+		wantErr bool
+	}{
+		"within budget":   {lags: map[string]int64{"orders/0": 10, "orders/1": 100}},
+		"no partitions":   {lags: map[string]int64{}},
+		"lagging":         {lags: map[string]int64{"orders/0": 10, "orders/1": 101}, wantErr: true},
+		"lag unavailable": {err: errors.New("coordinator not available"), wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			lagFn := func(context.Context) (map[string]int64, error) { return tt.lags, tt.err }
+
+			err := CheckConsumerLag(lagFn, 100)(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package health
+
+// Level is the severity of a log entry.
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+// Logger receives a summary log entry for every run, keysAndValues alternating keys and values.
+type Logger interface {
+	Log(level Level, msg string, keysAndValues ...any)
+}
+
+// defaultStatusLogLevels are the levels at which runs are logged, statuses missing from it are logged at error.
+var defaultStatusLogLevels = map[Status]Level{
+	StatusOK:                 LevelDebug,
+	StatusPartiallyAvailable: LevelWarn,
+}
+
+// logRun logs the summary of a stored run, if a logger is set, so the status is the one smoothed by WithHysteresis.
+func (h *Health) logRun(r Result) {
+	if h.logger == nil {
+		return
+	}
+
+	level, ok := h.statusLogLevels[r.Status]
+	if !ok {
+		level, ok = defaultStatusLogLevels[r.Status]
+	}
+	if !ok {
+		level = LevelError
+	}
+
+	h.logger.Log(level, "health checks performed", "status", r.Status, "checks", len(r.Checks), "failures", len(r.Failures))
+}
@@ -0,0 +1,75 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+// logEntry is an entry received by a recordingLogger.
+type logEntry struct {
+	level         Level
+	msg           string
+	keysAndValues []any
+}
+
+// recordingLogger records the entries it receives.
+type recordingLogger struct {
+	mu      sync.Mutex
+	entries []logEntry
+}
+
+func (l *recordingLogger) Log(level Level, msg string, keysAndValues ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries = append(l.entries, logEntry{level, msg, keysAndValues})
+}
+
+// logged returns the entries received so far.
+func (l *recordingLogger) logged() []logEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return append([]logEntry(nil), l.entries...)
+}
+
+func TestLogRunLevel(t *testing.T) {
+	tests := map[string]struct {
+		err       error
+		levels    map[Status]Level
+		wantLevel Level
+	}{
+		"ok at debug":                    {err: nil, wantLevel: LevelDebug},
+		"partially available at warn":    {err: fmt.Errorf("%w: slow", ErrDegraded), wantLevel: LevelWarn},
+		"unavailable at error":           {err: errors.New("down"), wantLevel: LevelError},
+		"ok at a custom level":           {err: nil, levels: map[Status]Level{StatusOK: LevelInfo}, wantLevel: LevelInfo},
+		"custom levels keep the default": {err: errors.New("down"), levels: map[Status]Level{StatusOK: LevelInfo}, wantLevel: LevelError},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			logger := &recordingLogger{}
+			h := newHealth(t,
+				WithLogger(logger),
+				WithStatusLogLevel(tt.levels),
+				WithChecks(Check{Name: "db", Check: checkFunc(tt.err)}),
+			)
+
+			r := h.Check(context.Background())
+
+			entries := logger.logged()
+			if len(entries) != 1 {
+				t.Fatalf("expected 1 log entry, got %d", len(entries))
+			}
+			if entries[0].level != tt.wantLevel {
+				t.Errorf("expected level %d, got %d", tt.wantLevel, entries[0].level)
+			}
+			if kv := entries[0].keysAndValues; len(kv) < 2 || kv[0] != "status" || kv[1] != r.Status {
+				t.Errorf("expected the status %q to be logged, got %v", r.Status, kv)
+			}
+		})
+	}
+}
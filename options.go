@@ -1,6 +1,9 @@
 package health
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 type Option func(*Health) error
 
@@ -26,10 +29,14 @@ func WithComponent(component Component) Option {
 	}
 }
 
-// WithMaxConcurrent sets max number of concurrently running checks.
+// WithMaxConcurrent sets max number of concurrently running checks, at least 1.
 // Set to 1 if want to run all checks sequentially.
 func WithMaxConcurrent(n int) Option {
 	return func(h *Health) error {
+		if n < 1 {
+			return fmt.Errorf("max concurrent checks must be at least 1, got %d", n)
+		}
+
 		h.maxConcurrent = n
 		return nil
 	}
@@ -42,3 +49,120 @@ func WithSystemInfo() Option {
 		return nil
 	}
 }
+
+// WithSkippedStatus sets the status reported when a run executes no checks at all.
+// By default the last known status is reused, falling back to OK.
+func WithSkippedStatus(s Status) Option {
+	return func(h *Health) error {
+		if !s.Valid() {
+			return fmt.Errorf("invalid skipped status %q", s)
+		}
+
+		h.skippedStatus = s
+		return nil
+	}
+}
+
+// WithResultTransform sets a function applied to every result right before it is returned,
+// allowing to redact, annotate or reshape it.
+func WithResultTransform(fn func(Result) Result) Option {
+	return func(h *Health) error {
+		h.transform = fn
+		return nil
+	}
+}
+
+// WithNameNormalizer sets a function applied to check names on registration and deregistration,
+// so names differing only by convention, e.g. "DB" and "db" with strings.ToLower, collide.
+// Checks registered by earlier options are registered again under their normalized name.
+func WithNameNormalizer(fn func(string) string) Option {
+	return func(h *Health) error {
+		h.normalize = fn
+
+		checks := h.registered()
+		for i := range h.shards {
+			h.shards[i].checks = make(map[string]Check)
+			h.shards[i].keys = make(map[string]int)
+		}
+
+		for _, c := range checks {
+			if err := h.Register(c); err != nil {
+				return fmt.Errorf("could not normalize check %q: %w", c.Name, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+// WithExecutionStrategy sets how the checks of a run are scheduled. Defaults to GoroutinePerCheck.
+func WithExecutionStrategy(s ExecutionStrategy) Option {
+	return func(h *Health) error {
+		if s < GoroutinePerCheck || s > Inline {
+			return fmt.Errorf("unknown execution strategy %d", s)
+		}
+
+		h.strategy = s
+		return nil
+	}
+}
+
+// WithCacheTTL caches results for d, so runs within d of each other return the same result instead of
+// performing the checks again. Filtered runs are cached separately for every distinct set of names.
+func WithCacheTTL(d time.Duration) Option {
+	return func(h *Health) error {
+		h.cacheTTL = d
+		return nil
+	}
+}
+
+// WithStaleWhileRevalidate makes runs return the cached result immediately, even a stale one, and refresh it
+// in the background once it is older than d. Only one refresh per set of checks runs at a time.
+func WithStaleWhileRevalidate(d time.Duration) Option {
+	return func(h *Health) error {
+		h.staleAfter = d
+		return nil
+	}
+}
+
+// WithLogger sets the logger receiving a summary entry for every run.
+func WithLogger(l Logger) Option {
+	return func(h *Health) error {
+		h.logger = l
+		return nil
+	}
+}
+
+// WithStatusLogLevel sets the level at which runs are logged depending on their status. By default OK runs are
+// logged at debug, Partially Available runs at warn and any other at error.
+func WithStatusLogLevel(levels map[Status]Level) Option {
+	return func(h *Health) error {
+		h.statusLogLevels = levels
+		return nil
+	}
+}
+
+// WithAvailabilityWindow keeps the statuses of the last n runs to compute Availability.
+func WithAvailabilityWindow(n int) Option {
+	return func(h *Health) error {
+		if n < 1 {
+			return fmt.Errorf("availability window must hold at least 1 run, got %d", n)
+		}
+
+		h.history = history{statuses: make([]Status, n)}
+		return nil
+	}
+}
+
+// WithHysteresis smooths the status of the runs of every check to prevent flapping: it requires upN consecutive
+// OK runs to recover and downN consecutive failing runs to fail. Failures are still reported in the meantime.
+func WithHysteresis(upN, downN int) Option {
+	return func(h *Health) error {
+		if upN < 1 || downN < 1 {
+			return fmt.Errorf("hysteresis requires at least 1 consecutive run, got %d and %d", upN, downN)
+		}
+
+		h.hysteresis = hysteresis{up: upN, down: downN}
+		return nil
+	}
+}
@@ -0,0 +1,69 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestWithResultTransform(t *testing.T) {
+	redact := func(r Result) Result {
+		failures := make(map[string]string, len(r.Failures))
+		for name := range r.Failures {
+			failures[name] = "redacted"
+		}
+		r.Failures = failures
+		r.System = nil
+
+		return r
+	}
+
+	h := newHealth(t,
+		WithResultTransform(redact),
+		WithChecks(Check{Name: "db", Check: checkFunc(errors.New("dial tcp 10.0.0.1:5432: connection refused"))}),
+	)
+
+	result := h.Check(context.Background())
+	if got := result.Failures["db"]; got != "redacted" {
+		t.Errorf("expected the failure to be redacted, got %q", got)
+	}
+	if result.System != nil {
+		t.Error("expected the system information to be dropped")
+	}
+
+	last, _ := h.LastResult()
+	if got := last.Failures["db"]; got != "redacted" {
+		t.Errorf("expected the last result to be redacted, got %q", got)
+	}
+}
+
+func TestWithNameNormalizer(t *testing.T) {
+	h := newHealth(t,
+		WithChecks(Check{Name: "Primary DB", Check: checkFunc(nil)}),
+		WithNameNormalizer(strings.ToLower),
+	)
+
+	if err := h.Register(Check{Name: "PRIMARY DB", Check: checkFunc(nil)}); err == nil {
+		t.Error("expected names differing by case to collide")
+	}
+
+	if _, ok := h.Check(context.Background()).Checks["primary db"]; !ok {
+		t.Error("expected the check registered before the normalizer to be normalized")
+	}
+
+	if got := len(h.CheckFiltered(context.Background(), "Primary DB").Checks); got != 1 {
+		t.Errorf("expected filtering to normalize names, got %d checks", got)
+	}
+
+	if err := h.Deregister("Primary Db"); err != nil {
+		t.Errorf("expected deregistration to normalize names, got %v", err)
+	}
+
+	if _, err := NewHealth(
+		WithChecks(Check{Name: "db", Check: checkFunc(nil)}, Check{Name: "DB", Check: checkFunc(nil)}),
+		WithNameNormalizer(strings.ToLower),
+	); err == nil {
+		t.Error("expected an error when normalized names of registered checks collide")
+	}
+}
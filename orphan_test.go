@@ -0,0 +1,42 @@
+package health
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestOrphanedChecks(t *testing.T) {
+	release := make(chan struct{})
+	h := newHealth(t, WithChecks(Check{
+		Name:    "stuck",
+		Timeout: 10 * time.Millisecond,
+		// ignores its context, so it keeps running after timing out
+		Check: func(context.Context) error {
+			<-release
+			return nil
+		},
+	}))
+
+	r := h.Check(context.Background())
+	if r.Failures["stuck"] != errTimeout.Error() {
+		t.Fatalf("expected the check to time out, got %v", r.Failures)
+	}
+	if r.OrphanedChecks != 1 {
+		t.Fatalf("expected 1 orphaned check, got %d", r.OrphanedChecks)
+	}
+
+	close(release)
+
+	deadline := time.Now().Add(time.Second)
+	for h.orphans.Load() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("expected the orphaned check to be released once it returned")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if r := h.Check(context.Background()); r.OrphanedChecks != 0 || r.Status != StatusOK {
+		t.Errorf("expected no orphaned check after it returned, got %d with status %q", r.OrphanedChecks, r.Status)
+	}
+}
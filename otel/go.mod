@@ -0,0 +1,20 @@
+module github.com/pcordeiro/go-health/otel
+
+go 1.19
+
+require (
+	github.com/pcordeiro/go-health v0.0.0-00010101000000-000000000000
+	go.opentelemetry.io/otel v1.16.0
+	go.opentelemetry.io/otel/metric v1.16.0
+	go.opentelemetry.io/otel/sdk v1.16.0
+	go.opentelemetry.io/otel/sdk/metric v0.39.0
+	go.opentelemetry.io/otel/trace v1.16.0
+)
+
+require (
+	github.com/go-logr/logr v1.2.4 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	golang.org/x/sys v0.13.0 // indirect
+)
+
+replace github.com/pcordeiro/go-health => ../
@@ -0,0 +1,49 @@
+// Package otel exports health check results to OpenTelemetry.
+package otel
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// statusValues maps the statuses to the value recorded by the gauges.
+var statusValues = map[health.Status]float64{
+	health.StatusOK:                 1,
+	health.StatusPartiallyAvailable: 0.5,
+}
+
+// RegisterMetrics registers two observable gauges reporting the last run of h: health.status for the overall
+// status and health.check.status, with a check attribute, for every check. OK is recorded as 1,
+// Partially Available as 0.5 and any other status as 0. Nothing is recorded before the first run.
+func RegisterMetrics(h *health.Health, meter metric.Meter) (metric.Registration, error) {
+	overall, err := meter.Float64ObservableGauge("health.status",
+		metric.WithDescription("Overall status of the last health check run: 1 OK, 0.5 partially available, 0 unavailable."))
+	if err != nil {
+		return nil, fmt.Errorf("could not create health.status gauge: %w", err)
+	}
+
+	perCheck, err := meter.Float64ObservableGauge("health.check.status",
+		metric.WithDescription("Status of each check in the last health check run: 1 OK, 0.5 degraded, 0 unavailable."))
+	if err != nil {
+		return nil, fmt.Errorf("could not create health.check.status gauge: %w", err)
+	}
+
+	return meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		r, ok := h.LastResult()
+		if !ok {
+			return nil
+		}
+
+		o.ObserveFloat64(overall, statusValues[r.Status])
+		for name, c := range r.Checks {
+			o.ObserveFloat64(perCheck, statusValues[c.Status], metric.WithAttributes(attribute.String("check", name)))
+		}
+
+		return nil
+	}, overall, perCheck)
+}
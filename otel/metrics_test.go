@@ -0,0 +1,80 @@
+package otel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// collectGauges collects the gauges of reader, keyed by name and, for health.check.status, by check.
+func collectGauges(t *testing.T, reader sdkmetric.Reader) map[string]float64 {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("could not collect metrics: %v", err)
+	}
+
+	values := make(map[string]float64)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			gauge, ok := m.Data.(metricdata.Gauge[float64])
+			if !ok {
+				t.Fatalf("expected %q to be a gauge, got %T", m.Name, m.Data)
+			}
+
+			for _, dp := range gauge.DataPoints {
+				key := m.Name
+				if check, ok := dp.Attributes.Value("check"); ok {
+					key = fmt.Sprintf("%s{%s}", m.Name, check.AsString())
+				}
+				values[key] = dp.Value
+			}
+		}
+	}
+
+	return values
+}
+
+func TestRegisterMetrics(t *testing.T) {
+	h, err := health.NewHealth(health.WithChecks(
+		health.Check{Name: "db", Check: func(context.Context) error { return nil }},
+		health.Check{Name: "search", Check: func(context.Context) error { return fmt.Errorf("%w: slow", health.ErrDegraded) }},
+		health.Check{Name: "cache", Check: func(context.Context) error { return errors.New("down") }},
+	))
+	if err != nil {
+		t.Fatalf("could not create health: %v", err)
+	}
+
+	reader := sdkmetric.NewManualReader()
+	meter := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("health")
+
+	if _, err := RegisterMetrics(h, meter); err != nil {
+		t.Fatalf("could not register metrics: %v", err)
+	}
+
+	if got := collectGauges(t, reader); len(got) != 0 {
+		t.Errorf("expected nothing to be recorded before the first run, got %v", got)
+	}
+
+	h.Check(context.Background())
+
+	want := map[string]float64{
+		"health.status":               0,
+		"health.check.status{db}":     1,
+		"health.check.status{search}": 0.5,
+		"health.check.status{cache}":  0,
+	}
+	got := collectGauges(t, reader)
+	for key, value := range want {
+		if v, ok := got[key]; !ok || v != value {
+			t.Errorf("expected %s to be %v, got %v", key, value, got[key])
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package otel
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/pcordeiro/go-health"
+)
+
+// TraceHandler wraps a health handler, e.g. the one returned by Health.Handler, starting a span for every probe.
+// The checks are performed with the probe context, so the spans they start, e.g. with TraceCheck, nest under
+// the probe span and share its trace ID. The trace ID of every probe is logged with logger, if set.
+func TraceHandler(next http.Handler, tracer trace.Tracer, logger health.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := tracer.Start(r.Context(), "health.probe", trace.WithSpanKind(trace.SpanKindServer))
+		defer span.End()
+
+		if logger != nil {
+			logger.Log(health.LevelDebug, "health probe", "trace_id", span.SpanContext().TraceID().String())
+		}
+
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(sw, r.WithContext(ctx))
+
+		span.SetAttributes(attribute.Int("http.status_code", sw.status))
+		if sw.status != http.StatusOK {
+			span.SetStatus(codes.Error, http.StatusText(sw.status))
+		}
+	})
+}
+
+// TraceCheck wraps a check so every call is recorded as a span named after the check, a child of the span
+// carried by the context.
+func TraceCheck(tracer trace.Tracer, name string, fn health.CheckFunc) health.CheckFunc {
+	return func(ctx context.Context) error {
+		ctx, span := tracer.Start(ctx, "health.check "+name, trace.WithAttributes(attribute.String("check", name)))
+		defer span.End()
+
+		err := fn(ctx)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		return err
+	}
+}
+
+// statusWriter records the status code written by a handler.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(code int) {
+	w.status = code
+	w.ResponseWriter.WriteHeader(code)
+}
@@ -0,0 +1,85 @@
+package otel
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/pcordeiro/go-health"
+)
+
+type logEntry struct {
+	msg           string
+	keysAndValues []any
+}
+
+type recordingLogger struct {
+	mu      sync.Mutex
+	entries []logEntry
+}
+
+func (l *recordingLogger) Log(_ health.Level, msg string, keysAndValues ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries = append(l.entries, logEntry{msg, keysAndValues})
+}
+
+func TestTraceHandler(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("health")
+
+	h, err := health.NewHealth(health.WithChecks(
+		health.Check{Name: "db", Check: TraceCheck(tracer, "db", func(context.Context) error { return nil })},
+		health.Check{Name: "cache", Check: TraceCheck(tracer, "cache", func(context.Context) error { return errors.New("down") })},
+	))
+	if err != nil {
+		t.Fatalf("could not create health: %v", err)
+	}
+
+	logger := &recordingLogger{}
+	w := httptest.NewRecorder()
+	TraceHandler(h.Handler(), tracer, logger).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	spans := recorder.Ended()
+	if len(spans) != 3 {
+		t.Fatalf("expected 3 spans, got %d", len(spans))
+	}
+
+	var probe sdktrace.ReadOnlySpan
+	for _, s := range spans {
+		if s.Name() == "health.probe" {
+			probe = s
+		}
+	}
+	if probe == nil {
+		t.Fatal("expected a probe span")
+	}
+
+	traceID := probe.SpanContext().TraceID()
+	for _, s := range spans {
+		if s == probe {
+			continue
+		}
+
+		if s.SpanContext().TraceID() != traceID {
+			t.Errorf("expected span %q to share the probe trace ID", s.Name())
+		}
+		if s.Parent().SpanID() != probe.SpanContext().SpanID() {
+			t.Errorf("expected span %q to be a child of the probe span", s.Name())
+		}
+	}
+
+	if len(logger.entries) != 1 {
+		t.Fatalf("expected 1 log entry, got %d", len(logger.entries))
+	}
+	if kv := logger.entries[0].keysAndValues; len(kv) != 2 || kv[0] != "trace_id" || kv[1] != traceID.String() {
+		t.Errorf("expected the trace ID to be logged, got %v", kv)
+	}
+}
@@ -0,0 +1,50 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestQuickCheck(t *testing.T) {
+	errQuick := errors.New("connection refused")
+	errFull := errors.New("replication lag")
+
+	tests := map[string]struct {
+		quick      error
+		full       error
+		wantFull   bool
+		wantStatus Status
+	}{
+		"quick success skips the full check": {quick: nil, full: errFull, wantStatus: StatusOK},
+		"quick failure skips the full check": {quick: errQuick, full: nil, wantStatus: StatusUnavailable},
+		"inconclusive runs the full check":   {quick: ErrInconclusive, full: errFull, wantFull: true, wantStatus: StatusUnavailable},
+		"wrapped inconclusive runs the full check": {
+			quick: fmt.Errorf("cache miss: %w", ErrInconclusive), full: nil, wantFull: true, wantStatus: StatusOK,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fullRan := false
+			h := newHealth(t, WithChecks(Check{
+				Name:  "db",
+				Quick: checkFunc(tt.quick),
+				Check: func(context.Context) error {
+					fullRan = true
+					return tt.full
+				},
+			}))
+
+			r := h.Check(context.Background())
+
+			if fullRan != tt.wantFull {
+				t.Errorf("expected the full check to run: %v, got %v", tt.wantFull, fullRan)
+			}
+			if r.Status != tt.wantStatus {
+				t.Errorf("expected status %q, got %q", tt.wantStatus, r.Status)
+			}
+		})
+	}
+}
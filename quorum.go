@@ -0,0 +1,54 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// NewQuorumCheck creates a single logical check backed by several endpoints, e.g. the replicas of a service,
+// which are checked concurrently. It succeeds when every endpoint is up, reports a degradation when fewer
+// are up but at least quorum, and fails below quorum. It returns an error unless the quorum is between 1 and
+// the number of endpoints.
+func NewQuorumCheck(name string, quorum int, endpoints ...CheckFunc) (Check, error) {
+	if quorum < 1 || quorum > len(endpoints) {
+		return Check{}, fmt.Errorf("quorum must be between 1 and %d endpoints, got %d", len(endpoints), quorum)
+	}
+
+	return Check{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			errs := make([]error, len(endpoints))
+
+			var wg sync.WaitGroup
+			for i, e := range endpoints {
+				wg.Add(1)
+
+				go func(i int, e CheckFunc) {
+					defer wg.Done()
+
+					errs[i] = callCheck(ctx, e)
+				}(i, e)
+			}
+			wg.Wait()
+
+			var failed []string
+			for i, err := range errs {
+				if err != nil {
+					failed = append(failed, fmt.Sprintf("endpoint %d: %v", i, err))
+				}
+			}
+
+			up := len(endpoints) - len(failed)
+			switch {
+			case len(failed) == 0:
+				return nil
+			case up >= quorum:
+				return fmt.Errorf("%w: %d of %d endpoints up (%s)", ErrDegraded, up, len(endpoints), strings.Join(failed, "; "))
+			default:
+				return fmt.Errorf("%d of %d endpoints up, quorum is %d (%s)", up, len(endpoints), quorum, strings.Join(failed, "; "))
+			}
+		},
+	}, nil
+}
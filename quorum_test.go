@@ -0,0 +1,63 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestNewQuorumCheck(t *testing.T) {
+	up, down := checkFunc(nil), checkFunc(errors.New("connection refused"))
+
+	tests := map[string]struct {
+		quorum       int
+		endpoints    []CheckFunc
+		wantErr      bool
+		wantDegraded bool
+	}{
+		"all up":      {quorum: 2, endpoints: []CheckFunc{up, up, up}},
+		"quorum met":  {quorum: 2, endpoints: []CheckFunc{up, down, up}, wantErr: true, wantDegraded: true},
+		"quorum lost": {quorum: 2, endpoints: []CheckFunc{up, down, down}, wantErr: true},
+		"all down":    {quorum: 1, endpoints: []CheckFunc{down, down}, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			check, err := NewQuorumCheck("replicas", tt.quorum, tt.endpoints...)
+			if err != nil {
+				t.Fatalf("could not create check: %v", err)
+			}
+
+			err = check.Check(context.Background())
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v, got %v", tt.wantErr, err)
+			}
+			if errors.Is(err, ErrDegraded) != tt.wantDegraded {
+				t.Errorf("expected a degradation: %v, got %v", tt.wantDegraded, err)
+			}
+		})
+	}
+}
+
+func TestNewQuorumCheckInvalid(t *testing.T) {
+	up := checkFunc(nil)
+
+	tests := map[string]struct {
+		quorum    int
+		endpoints []CheckFunc
+	}{
+		"zero quorum":      {quorum: 0, endpoints: []CheckFunc{up, up}},
+		"negative quorum":  {quorum: -1, endpoints: []CheckFunc{up}},
+		"quorum too large": {quorum: 3, endpoints: []CheckFunc{up, up}},
+		"no endpoints":     {quorum: 1},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := NewQuorumCheck("replicas", tt.quorum, tt.endpoints...); err == nil {
+				t.Error("expected an error for an invalid quorum")
+			}
+		})
+	}
+}
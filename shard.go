@@ -0,0 +1,76 @@
+package health
+
+import (
+	"hash/fnv"
+	"sync"
+)
+
+// shardCount is the number of shards the registered checks are spread across, so concurrent
+// registrations of different checks rarely contend on the same lock.
+const shardCount = 16
+
+type shard struct {
+	mu     sync.RWMutex
+	checks map[string]Check
+	// keys counts the registered checks per dependency key hashing to the shard.
+	keys map[string]int
+}
+
+// shard returns the shard holding the check with the given name.
+func (h *Health) shard(name string) *shard {
+	return &h.shards[h.shardIndex(name)]
+}
+
+// shardIndex returns the index of the shard holding the check with the given name.
+func (h *Health) shardIndex(name string) int {
+	f := fnv.New32a()
+	_, _ = f.Write([]byte(name))
+
+	return int(f.Sum32() % uint32(len(h.shards)))
+}
+
+// lockShards locks the shards of both names for writing, in index order so concurrent callers cannot deadlock,
+// and returns the function unlocking them.
+func (h *Health) lockShards(a, b string) (unlock func()) {
+	i, j := h.shardIndex(a), h.shardIndex(b)
+	if i > j {
+		i, j = j, i
+	}
+
+	h.shards[i].mu.Lock()
+	if i == j {
+		return h.shards[i].mu.Unlock
+	}
+	h.shards[j].mu.Lock()
+
+	return func() {
+		h.shards[j].mu.Unlock()
+		h.shards[i].mu.Unlock()
+	}
+}
+
+// registered returns a snapshot of every registered check.
+func (h *Health) registered() []Check {
+	var checks []Check
+
+	for i := range h.shards {
+		sh := &h.shards[i]
+
+		sh.mu.RLock()
+		for _, c := range sh.checks {
+			checks = append(checks, c)
+		}
+		sh.mu.RUnlock()
+	}
+
+	return checks
+}
+
+// normalizeName applies the configured name normalizer, if any.
+func (h *Health) normalizeName(name string) string {
+	if h.normalize == nil {
+		return name
+	}
+
+	return h.normalize(name)
+}
@@ -0,0 +1,115 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRegisterDeregister(t *testing.T) {
+	h := newHealth(t)
+
+	if err := h.Register(Check{Name: "db", Check: checkFunc(nil)}); err != nil {
+		t.Fatalf("could not register check: %v", err)
+	}
+	if err := h.Register(Check{Name: "db", Check: checkFunc(nil)}); err == nil {
+		t.Error("expected an error registering a check twice")
+	}
+	if err := h.Register(Check{Check: checkFunc(nil)}); err == nil {
+		t.Error("expected an error registering a check without a name")
+	}
+
+	if err := h.Deregister("db"); err != nil {
+		t.Fatalf("could not deregister check: %v", err)
+	}
+	if err := h.Deregister("db"); err == nil {
+		t.Error("expected an error deregistering an unknown check")
+	}
+
+	if got := len(h.Check(context.Background()).Checks); got != 0 {
+		t.Errorf("expected no check to be performed, got %d", got)
+	}
+}
+
+func TestConcurrentRegistration(t *testing.T) {
+	const (
+		workers = 8
+		checks  = 50
+	)
+
+	h := newHealth(t)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+
+		go func(w int) {
+			defer wg.Done()
+
+			for i := 0; i < checks; i++ {
+				name := fmt.Sprintf("check-%d-%d", w, i)
+				if err := h.Register(Check{Name: name, Check: checkFunc(nil)}); err != nil {
+					t.Errorf("could not register %q: %v", name, err)
+				}
+
+				// keep every other check registered
+				if i%2 == 1 {
+					if err := h.Deregister(name); err != nil {
+						t.Errorf("could not deregister %q: %v", name, err)
+					}
+				}
+			}
+		}(w)
+	}
+
+	var stop atomic.Bool
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		for !stop.Load() {
+			if r := h.Check(context.Background()); r.Status != StatusOK {
+				t.Errorf("expected %q while registering, got %q", StatusOK, r.Status)
+			}
+		}
+	}()
+
+	wg.Wait()
+	stop.Store(true)
+	<-done
+
+	result := h.Check(context.Background())
+	if got, want := len(result.Checks), workers*checks/2; got != want {
+		t.Errorf("expected %d registered checks, got %d", want, got)
+	}
+}
+
+func BenchmarkRegisterDeregister(b *testing.B) {
+	// a single shard is the baseline of a registry behind a single lock
+	for _, shards := range []int{1, shardCount} {
+		b.Run(fmt.Sprintf("shards=%d", shards), func(b *testing.B) {
+			h := newHealth(b)
+			h.shards = h.shards[:shards]
+
+			var id atomic.Int64
+			b.RunParallel(func(pb *testing.PB) {
+				prefix := fmt.Sprintf("check-%d-", id.Add(1))
+
+				for i := 0; pb.Next(); i++ {
+					name := prefix + fmt.Sprint(i)
+
+					if err := h.Register(Check{Name: name, Check: checkFunc(nil)}); err != nil {
+						b.Error(err)
+						return
+					}
+					if err := h.Deregister(name); err != nil {
+						b.Error(err)
+						return
+					}
+				}
+			})
+		})
+	}
+}
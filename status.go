@@ -0,0 +1,51 @@
+package health
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var (
+	statusesMu sync.RWMutex
+	// knownStatuses holds every status a Result can carry, along with the registered custom ones.
+	knownStatuses = map[Status]bool{
+		StatusOK:                 true,
+		StatusPartiallyAvailable: true,
+		StatusUnavailable:        true,
+		StatusTimeout:            true,
+		StatusUnknown:            true,
+	}
+)
+
+// RegisterStatus registers a custom status, e.g. one set by a result transform, so ParseStatus and Valid accept it.
+func RegisterStatus(s Status) error {
+	if s == "" {
+		return errors.New("health status must not be empty")
+	}
+
+	statusesMu.Lock()
+	defer statusesMu.Unlock()
+
+	knownStatuses[s] = true
+
+	return nil
+}
+
+// ParseStatus returns the Status matching s, or an error if s is neither a known nor a registered status.
+func ParseStatus(s string) (Status, error) {
+	status := Status(s)
+	if !status.Valid() {
+		return "", fmt.Errorf("unknown health status %q", s)
+	}
+
+	return status, nil
+}
+
+// Valid reports whether s is a known or registered status.
+func (s Status) Valid() bool {
+	statusesMu.RLock()
+	defer statusesMu.RUnlock()
+
+	return knownStatuses[s]
+}
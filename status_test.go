@@ -0,0 +1,58 @@
+package health
+
+import "testing"
+
+func TestParseStatus(t *testing.T) {
+	tests := map[string]struct {
+		in      string
+		wantErr bool
+	}{
+		"ok":                  {in: "OK"},
+		"partially available": {in: "Partially Available"},
+		"unavailable":         {in: "Unavailable"},
+		"timeout":             {in: "Timeout during health check"},
+		"unknown":             {in: "Unknown"},
+		"wrong case":          {in: "ok", wantErr: true},
+		"empty":               {in: "", wantErr: true},
+		"made up":             {in: "Sleepy", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			s, err := ParseStatus(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected an error, got status %q", s)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if s != Status(tt.in) || !s.Valid() {
+				t.Errorf("expected valid status %q, got %q", tt.in, s)
+			}
+		})
+	}
+}
+
+func TestRegisterStatus(t *testing.T) {
+	const maintenance Status = "Maintenance"
+
+	if maintenance.Valid() {
+		t.Fatal("expected the custom status to be invalid before registration")
+	}
+
+	if err := RegisterStatus(maintenance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if s, err := ParseStatus("Maintenance"); err != nil || s != maintenance {
+		t.Errorf("expected %q, got %q and %v", maintenance, s, err)
+	}
+
+	if err := RegisterStatus(""); err == nil {
+		t.Error("expected an error registering an empty status")
+	}
+}
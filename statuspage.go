@@ -0,0 +1,89 @@
+package health
+
+import (
+	"html/template"
+	"net/http"
+	"sort"
+	"time"
+)
+
+var statusPage = template.Must(template.New("status").Funcs(template.FuncMap{
+	"statusClass": statusClass,
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{with .Component.Name}}{{.}} - {{end}}Health</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ddd; padding: .4em .8em; text-align: left; }
+.status-ok { color: #1a7f37; }
+.status-partially-available { color: #9a6700; }
+.status-unavailable, .status-timeout { color: #cf222e; }
+.status-unknown { color: #6e7781; }
+</style>
+</head>
+<body>
+<h1>{{with .Component.Name}}{{.}} {{end}}<span class="{{statusClass .Status}}">{{.Status}}</span></h1>
+<p>{{with .Component.Version}}Version {{.}} - {{end}}Checked at {{.Timestamp.Format "2006-01-02 15:04:05 MST"}}</p>
+<table>
+<tr><th>Check</th><th>Description</th><th>Status</th><th>Duration</th><th>Error</th></tr>
+{{range .Checks}}<tr class="{{statusClass .Status}}"><td>{{.Name}}</td><td>{{.Description}}</td><td>{{.Status}}</td><td>{{.Duration}}</td><td>{{.Error}}</td></tr>
+{{end}}</table>
+</body>
+</html>
+`))
+
+type statusPageRow struct {
+	Name        string
+	Description string
+	CheckResult
+}
+
+// StatusPageHandler returns a handler performing the checks and rendering their result as an HTML page,
+// going through the cache configured with WithCacheTTL or WithStaleWhileRevalidate. It responds with 503
+// unless the status is OK.
+func (h *Health) StatusPageHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		result := h.Check(r.Context())
+
+		descriptions := make(map[string]string)
+		for _, c := range h.registered() {
+			descriptions[c.Name] = c.Description
+		}
+
+		rows := make([]statusPageRow, 0, len(result.Checks))
+		for name, c := range result.Checks {
+			c.Duration = c.Duration.Round(time.Microsecond)
+			rows = append(rows, statusPageRow{Name: name, Description: descriptions[name], CheckResult: c})
+		}
+		sort.Slice(rows, func(i, j int) bool { return rows[i].Name < rows[j].Name })
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if result.Status != StatusOK {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+
+		_ = statusPage.Execute(w, struct {
+			Result
+			Checks []statusPageRow
+		}{result, rows})
+	})
+}
+
+// statusClass returns the CSS class of a status.
+func statusClass(s Status) string {
+	switch s {
+	case StatusOK:
+		return "status-ok"
+	case StatusPartiallyAvailable:
+		return "status-partially-available"
+	case StatusTimeout:
+		return "status-timeout"
+	case StatusUnknown:
+		return "status-unknown"
+	default:
+		return "status-unavailable"
+	}
+}
@@ -0,0 +1,70 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestStatusPageHandler(t *testing.T) {
+	h := newHealth(t,
+		WithComponent(Component{Name: "api", Version: "1.2.3"}),
+		WithChecks(
+			Check{Name: "db", Description: "primary <database>", Check: checkFunc(nil)},
+			Check{Name: "cache", Check: checkFunc(errors.New("connection refused"))},
+		),
+	)
+
+	w := httptest.NewRecorder()
+	h.StatusPageHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/status", nil))
+
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected status code %d, got %d", http.StatusServiceUnavailable, w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/html") {
+		t.Errorf("expected an HTML response, got %q", ct)
+	}
+
+	body := w.Body.String()
+	for _, want := range []string{
+		"api",
+		"Version 1.2.3",
+		"primary &lt;database&gt;",
+		"connection refused",
+		`<td>db</td><td>primary &lt;database&gt;</td><td>OK</td>`,
+		`class="status-unavailable"`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected the page to contain %q:\n%s", want, body)
+		}
+	}
+}
+
+func TestStatusPageHandlerReportsNewFailures(t *testing.T) {
+	var err error
+	h := newHealth(t, WithChecks(Check{Name: "db", Check: func(context.Context) error { return err }}))
+
+	for _, tt := range []struct {
+		err      error
+		wantCode int
+		want     string
+	}{
+		{wantCode: http.StatusOK, want: `class="status-ok">OK</span>`},
+		{err: errors.New("connection refused"), wantCode: http.StatusServiceUnavailable, want: "connection refused"},
+	} {
+		err = tt.err
+
+		w := httptest.NewRecorder()
+		h.StatusPageHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/status", nil))
+
+		if w.Code != tt.wantCode {
+			t.Errorf("expected status code %d, got %d", tt.wantCode, w.Code)
+		}
+		if !strings.Contains(w.Body.String(), tt.want) {
+			t.Errorf("expected the page to contain %q:\n%s", tt.want, w.Body.String())
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ExecutionStrategy defines how the checks of a run are scheduled.
+type ExecutionStrategy int
+
+const (
+	// GoroutinePerCheck starts a goroutine per check, at most maxConcurrent at a time.
+	// Checks exceeding their timeout are abandoned. This is the default.
+	GoroutinePerCheck ExecutionStrategy = iota
+	// WorkerPool runs the checks on maxConcurrent workers started by the first run and kept until Close is called,
+	// so no goroutine is started per run or per check. A check exceeding its timeout can only be interrupted
+	// through its context, it is reported as timed out once it returns and holds its worker until then: a check
+	// ignoring its context delays the checks queued behind it, and the run, past their timeouts.
+	WorkerPool
+	// Inline runs the checks sequentially on the calling goroutine. No goroutine is started, so a check
+	// exceeding its timeout can only be interrupted through its context and is reported as timed out once it returns.
+	Inline
+)
+
+// execute calls run for every check according to the execution strategy and waits for all of them.
+func (h *Health) execute(checks []Check, run func(Check)) {
+	switch h.strategy {
+	case Inline:
+		for _, c := range checks {
+			run(c)
+		}
+	case WorkerPool:
+		jobs := h.workers.acquire(h.maxConcurrent)
+
+		var wg sync.WaitGroup
+		for _, c := range checks {
+			c := c
+			wg.Add(1)
+
+			jobs <- func() {
+				defer wg.Done()
+
+				run(c)
+			}
+		}
+
+		h.workers.mu.RUnlock()
+		wg.Wait()
+	default:
+		limiterCh := make(chan bool, h.maxConcurrent)
+		defer close(limiterCh)
+
+		var wg sync.WaitGroup
+		for _, c := range checks {
+			limiterCh <- true
+			wg.Add(1)
+
+			go func(c Check) {
+				// wg.Done is deferred first so it runs last, even if run panics.
+				defer wg.Done()
+				defer func() { <-limiterCh }()
+
+				run(c)
+			}(c)
+		}
+
+		wg.Wait()
+	}
+}
+
+// workers are the goroutines of the WorkerPool strategy, receiving the jobs to run. A run holds mu for reading
+// while submitting its jobs, stopping the workers holds it for writing.
+type workers struct {
+	mu   sync.RWMutex
+	jobs chan func()
+}
+
+// acquire returns the jobs channel with mu held for reading, starting n workers if they are not running.
+// The caller must release mu once its jobs are submitted.
+func (w *workers) acquire(n int) chan<- func() {
+	w.mu.RLock()
+	for w.jobs == nil {
+		w.mu.RUnlock()
+
+		w.mu.Lock()
+		if w.jobs == nil {
+			w.jobs = make(chan func())
+
+			for i := 0; i < n; i++ {
+				go func(jobs <-chan func()) {
+					for job := range jobs {
+						job()
+					}
+				}(w.jobs)
+			}
+		}
+		w.mu.Unlock()
+
+		w.mu.RLock()
+	}
+
+	return w.jobs
+}
+
+// stop stops the workers once they finished their current job.
+func (w *workers) stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.jobs != nil {
+		close(w.jobs)
+		w.jobs = nil
+	}
+}
+
+// Close stops the workers of the WorkerPool strategy once they finished the checks submitted to them. It does
+// nothing for the other strategies and can be called more than once; a run after Close starts the workers again.
+func (h *Health) Close() {
+	h.workers.stop()
+}
+
+// runCheck executes the check with a context bound to its timeout, returning its error, errTimeout if it did
+// not finish in time or an error describing the panic if it panicked.
+func (h *Health) runCheck(ctx context.Context, c Check) error {
+	timeout := scaleTimeout(ctx, c.Timeout)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if h.strategy != GoroutinePerCheck {
+		err := callCheck(ctx, c.perform)
+		if ctx.Err() == context.DeadlineExceeded {
+			return errTimeout
+		}
+
+		return err
+	}
+
+	// resCh is buffered so the check goroutine does not leak when the timeout fires first.
+	resCh := make(chan error, 1)
+
+	// state goes from running to either finished or orphaned, whichever happens first.
+	const (
+		running int32 = iota
+		finished
+		orphaned
+	)
+	var state atomic.Int32
+
+	go func() {
+		resCh <- callCheck(ctx, c.perform)
+
+		if !state.CompareAndSwap(running, finished) {
+			h.orphans.Add(-1)
+		}
+	}()
+
+	select {
+	case <-time.After(timeout):
+		// prefer a result which arrived at the same time as the timeout
+		select {
+		case err := <-resCh:
+			return timedOut(ctx, err)
+		default:
+		}
+
+		if state.CompareAndSwap(running, orphaned) {
+			h.orphans.Add(1)
+		}
+
+		return errTimeout
+	case err := <-resCh:
+		return timedOut(ctx, err)
+	}
+}
+
+// timedOut returns errTimeout if the check failed because its context reached the deadline, err otherwise.
+func timedOut(ctx context.Context, err error) error {
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		return errTimeout
+	}
+
+	return err
+}
+
+// callCheck calls fn, turning a panic into an error.
+func callCheck(ctx context.Context, fn CheckFunc) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return fn(ctx)
+}
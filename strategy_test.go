@@ -0,0 +1,193 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var strategies = map[string]ExecutionStrategy{
+	"goroutine per check": GoroutinePerCheck,
+	"worker pool":         WorkerPool,
+	"inline":              Inline,
+}
+
+func TestExecutionStrategies(t *testing.T) {
+	for name, s := range strategies {
+		t.Run(name, func(t *testing.T) {
+			var running, peak atomic.Int32
+			track := func(err error) CheckFunc {
+				return func(ctx context.Context) error {
+					n := running.Add(1)
+					defer running.Add(-1)
+
+					for p := peak.Load(); n > p && !peak.CompareAndSwap(p, n); p = peak.Load() {
+					}
+					time.Sleep(5 * time.Millisecond)
+
+					return err
+				}
+			}
+
+			h := newHealth(t,
+				WithExecutionStrategy(s),
+				WithMaxConcurrent(2),
+				WithChecks(
+					Check{Name: "a", Check: track(nil)},
+					Check{Name: "b", Check: track(nil)},
+					Check{Name: "c", Check: track(errors.New("down")), SkipOnErr: true},
+					Check{Name: "d", Check: track(nil)},
+					Check{Name: "slow", Timeout: 10 * time.Millisecond, Check: func(ctx context.Context) error {
+						<-ctx.Done()
+						return ctx.Err()
+					}},
+					Check{Name: "panics", Check: func(context.Context) error { panic("boom") }},
+				),
+			)
+
+			for i := 0; i < 3; i++ {
+				result := h.Check(context.Background())
+
+				want := map[string]Status{
+					"a":      StatusOK,
+					"b":      StatusOK,
+					"c":      StatusUnavailable,
+					"d":      StatusOK,
+					"slow":   StatusTimeout,
+					"panics": StatusUnavailable,
+				}
+				for check, status := range want {
+					if got := result.Checks[check].Status; got != status {
+						t.Errorf("expected check %q to be %q, got %q", check, status, got)
+					}
+				}
+				if result.Status != StatusUnavailable {
+					t.Errorf("expected %q, got %q", StatusUnavailable, result.Status)
+				}
+			}
+
+			max := int32(2)
+			if s == Inline {
+				max = 1
+			}
+			if p := peak.Load(); p > max {
+				t.Errorf("expected at most %d checks to run concurrently, got %d", max, p)
+			}
+		})
+	}
+}
+
+func TestWorkerPoolGoroutines(t *testing.T) {
+	checks := make([]Check, 20)
+	for i := range checks {
+		checks[i] = Check{Name: fmt.Sprint(i), Check: checkFunc(nil)}
+	}
+
+	var peak atomic.Int32
+	checks = append(checks, Check{Name: "count", Check: func(context.Context) error {
+		peak.Store(int32(runtime.NumGoroutine()))
+		return nil
+	}})
+
+	h := newHealth(t, WithExecutionStrategy(WorkerPool), WithMaxConcurrent(2), WithChecks(checks...))
+	h.Check(context.Background())
+
+	before := runtime.NumGoroutine()
+	for i := 0; i < 10; i++ {
+		h.Check(context.Background())
+	}
+
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("expected the workers to be reused, goroutines went from %d to %d", before, after)
+	}
+	if p := int(peak.Load()); p > before {
+		t.Errorf("expected no goroutine per check, got %d goroutines during a run and %d between runs", p, before)
+	}
+}
+
+func TestWorkerPoolClose(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	for i := 0; i < 50; i++ {
+		h, err := NewHealth(WithExecutionStrategy(WorkerPool), WithMaxConcurrent(4), WithChecks(Check{Name: "db", Check: checkFunc(nil)}))
+		if err != nil {
+			t.Fatalf("could not create health: %v", err)
+		}
+
+		h.Check(context.Background())
+		h.Close()
+		h.Close()
+	}
+
+	// the workers exit once they observe the closed channel
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("expected Close to stop the workers, goroutines went from %d to %d", before, after)
+	}
+
+	t.Run("restarts the workers", func(t *testing.T) {
+		h := newHealth(t, WithExecutionStrategy(WorkerPool), WithChecks(Check{Name: "db", Check: checkFunc(nil)}))
+		h.Close()
+
+		if s := h.Check(context.Background()).Status; s != StatusOK {
+			t.Errorf("expected %q after Close, got %q", StatusOK, s)
+		}
+	})
+}
+
+func TestWorkerPoolCheckIgnoringContext(t *testing.T) {
+	const sleep = 50 * time.Millisecond
+
+	h := newHealth(t, WithExecutionStrategy(WorkerPool), WithMaxConcurrent(1), WithChecks(
+		Check{Name: "stuck", Timeout: 10 * time.Millisecond, Check: func(context.Context) error {
+			time.Sleep(sleep)
+			return nil
+		}},
+		Check{Name: "db", Timeout: 10 * time.Millisecond, Check: checkFunc(nil)},
+	))
+
+	start := time.Now()
+	result := h.Check(context.Background())
+
+	// the stuck check holds the only worker past its timeout, so the run lasts until it returns
+	if d := time.Since(start); d < sleep {
+		t.Errorf("expected the run to wait for the check ignoring its context, took %v", d)
+	}
+	if got := result.Checks["stuck"].Status; got != StatusTimeout {
+		t.Errorf("expected the check to be %q once it returned, got %q", StatusTimeout, got)
+	}
+}
+
+func TestWithMaxConcurrentInvalid(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		if _, err := NewHealth(WithMaxConcurrent(n)); err == nil {
+			t.Errorf("expected an error for %d max concurrent checks", n)
+		}
+	}
+}
+
+func BenchmarkExecutionStrategies(b *testing.B) {
+	checks := make([]Check, 50)
+	for i := range checks {
+		checks[i] = Check{Name: fmt.Sprint(i), Check: checkFunc(nil)}
+	}
+
+	for name, s := range strategies {
+		b.Run(name, func(b *testing.B) {
+			h := newHealth(b, WithExecutionStrategy(s), WithMaxConcurrent(4), WithChecks(checks...))
+
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				h.Check(context.Background())
+			}
+		})
+	}
+}
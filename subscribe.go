@@ -0,0 +1,54 @@
+package health
+
+import "sync"
+
+// subscriberBuffer is the number of results buffered for each subscriber.
+const subscriberBuffer = 16
+
+// subscribers holds the channels receiving the results of the runs of every check.
+type subscribers struct {
+	mu    sync.Mutex
+	chans map[chan Result]struct{}
+}
+
+// Subscribe returns a channel receiving the result of every run of every check, and a func to unsubscribe
+// which closes the channel. Each subscriber has its own buffered channel: results are dropped for a subscriber
+// whose buffer is full rather than blocking the run.
+func (h *Health) Subscribe() (<-chan Result, func()) {
+	ch := make(chan Result, subscriberBuffer)
+
+	h.subscribers.mu.Lock()
+	if h.subscribers.chans == nil {
+		h.subscribers.chans = make(map[chan Result]struct{})
+	}
+	h.subscribers.chans[ch] = struct{}{}
+	h.subscribers.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			h.subscribers.mu.Lock()
+			defer h.subscribers.mu.Unlock()
+
+			delete(h.subscribers.chans, ch)
+			close(ch)
+		})
+	}
+}
+
+// publish sends the result of a run of every check to the subscribers without blocking.
+func (h *Health) publish(r Result) {
+	h.subscribers.mu.Lock()
+	defer h.subscribers.mu.Unlock()
+
+	if len(h.subscribers.chans) == 0 {
+		return
+	}
+
+	for ch := range h.subscribers.chans {
+		select {
+		case ch <- h.output(r):
+		default:
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestSubscribe(t *testing.T) {
+	var failing bool
+	h := newHealth(t, WithChecks(Check{Name: "db", Check: func(context.Context) error {
+		if failing {
+			return errors.New("down")
+		}
+		return nil
+	}}))
+	ctx := context.Background()
+
+	first, unsubscribeFirst := h.Subscribe()
+	second, unsubscribeSecond := h.Subscribe()
+	defer unsubscribeSecond()
+
+	h.Check(ctx)
+
+	for i, ch := range []<-chan Result{first, second} {
+		select {
+		case r := <-ch:
+			if r.Status != StatusOK {
+				t.Errorf("subscriber %d: expected status %q, got %q", i, StatusOK, r.Status)
+			}
+		default:
+			t.Errorf("subscriber %d: expected a result", i)
+		}
+	}
+
+	// results are copies, a subscriber altering one does not affect the others
+	failing = true
+	h.Check(ctx)
+	r := <-first
+	r.Failures["db"] = "altered"
+	if got := (<-second).Failures["db"]; got != "down" {
+		t.Errorf("expected each subscriber to receive its own copy, got %q", got)
+	}
+
+	unsubscribeFirst()
+	unsubscribeFirst()
+
+	if _, ok := <-first; ok {
+		t.Error("expected the channel to be closed on unsubscribe")
+	}
+
+	h.Check(ctx)
+	if _, ok := <-second; !ok {
+		t.Error("expected the remaining subscriber to keep receiving results")
+	}
+
+	if r := h.CheckFiltered(ctx, "db"); r.Status != StatusUnavailable {
+		t.Fatalf("expected status %q, got %q", StatusUnavailable, r.Status)
+	}
+	select {
+	case <-second:
+		t.Error("expected a run of a subset of the checks not to be published")
+	default:
+	}
+}
+
+func TestSubscribeFullBuffer(t *testing.T) {
+	h := newHealth(t, WithChecks(Check{Name: "db", Check: checkFunc(nil)}))
+
+	updates, unsubscribe := h.Subscribe()
+	defer unsubscribe()
+
+	for i := 0; i < subscriberBuffer+5; i++ {
+		h.Check(context.Background())
+	}
+
+	if n := len(updates); n != subscriberBuffer {
+		t.Errorf("expected the results beyond the buffer to be dropped, got %d buffered", n)
+	}
+}
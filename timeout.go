@@ -0,0 +1,33 @@
+package health
+
+import (
+	"context"
+	"time"
+)
+
+type timeoutScaleKey struct{}
+
+// WithTimeoutScale returns a copy of ctx which scales the timeout of every check performed with it by factor,
+// e.g. to give a manual deep probe more time than the routine one. A run with a scaled timeout bypasses the cache
+// set by WithCacheTTL or WithStaleWhileRevalidate, so it always performs the checks and its result is not cached,
+// it still becomes the last result of a run of every check.
+func WithTimeoutScale(ctx context.Context, factor float64) context.Context {
+	return context.WithValue(ctx, timeoutScaleKey{}, factor)
+}
+
+// timeoutScaled reports whether ctx scales the timeout of the checks.
+func timeoutScaled(ctx context.Context) bool {
+	factor, ok := ctx.Value(timeoutScaleKey{}).(float64)
+
+	return ok && factor > 0
+}
+
+// scaleTimeout returns the timeout scaled by the factor carried by ctx, if any.
+func scaleTimeout(ctx context.Context, timeout time.Duration) time.Duration {
+	factor, ok := ctx.Value(timeoutScaleKey{}).(float64)
+	if !ok || factor <= 0 {
+		return timeout
+	}
+
+	return time.Duration(float64(timeout) * factor)
+}
@@ -0,0 +1,76 @@
+package health
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithTimeoutScale(t *testing.T) {
+	const timeout = 100 * time.Millisecond
+
+	tests := map[string]struct {
+		scale  bool
+		factor float64
+		want   time.Duration
+	}{
+		"no scale":         {want: timeout},
+		"scaled up":        {scale: true, factor: 5, want: 5 * timeout},
+		"scaled down":      {scale: true, factor: 0.5, want: timeout / 2},
+		"invalid zero":     {scale: true, factor: 0, want: timeout},
+		"invalid negative": {scale: true, factor: -1, want: timeout},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var deadline time.Time
+			h := newHealth(t, WithChecks(Check{Name: "db", Timeout: timeout, Check: func(ctx context.Context) error {
+				deadline, _ = ctx.Deadline()
+				return nil
+			}}))
+
+			ctx := context.Background()
+			if tt.scale {
+				ctx = WithTimeoutScale(ctx, tt.factor)
+			}
+
+			start := time.Now()
+			if r := h.Check(ctx); r.Status != StatusOK {
+				t.Fatalf("expected status %q, got %q: %v", StatusOK, r.Status, r.Failures)
+			}
+
+			// the deadline is set right after the run starts
+			if got := deadline.Sub(start); got < tt.want || got > tt.want+timeout/10 {
+				t.Errorf("expected a deadline %s after the start, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTimeoutScaleBypassesCache(t *testing.T) {
+	for name, opt := range map[string]Option{
+		"cache TTL":              WithCacheTTL(time.Hour),
+		"stale-while-revalidate": WithStaleWhileRevalidate(time.Hour),
+	} {
+		t.Run(name, func(t *testing.T) {
+			var calls atomic.Int32
+			h := newHealth(t, opt, WithChecks(countingCheck("db", &calls, nil)))
+			deep := WithTimeoutScale(context.Background(), 3)
+
+			routine := h.Check(context.Background())
+
+			scaled := h.Check(deep)
+			if calls.Load() != 2 || scaled.Timestamp == routine.Timestamp {
+				t.Fatalf("expected the scaled run to bypass the cache, got %d calls", calls.Load())
+			}
+			if last, _ := h.LastResult(); last.Timestamp != scaled.Timestamp {
+				t.Error("expected the scaled run to become the last result")
+			}
+
+			if r := h.Check(context.Background()); r.Timestamp != routine.Timestamp || calls.Load() != 2 {
+				t.Errorf("expected the scaled run not to be cached, got %d calls", calls.Load())
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package health
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRegisterTimeout(t *testing.T) {
+	tests := map[string]struct {
+		timeout time.Duration
+		want    time.Duration
+		wantErr bool
+	}{
+		"zero defaults to 2s": {timeout: 0, want: 2 * time.Second},
+		"minimum":             {timeout: time.Millisecond, want: time.Millisecond},
+		"below the minimum":   {timeout: time.Microsecond, wantErr: true},
+		"negative":            {timeout: -time.Second, wantErr: true},
+		"longer than default": {timeout: time.Minute, want: time.Minute},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := newHealth(t)
+
+			err := h.Register(Check{Name: "db", Timeout: tt.timeout, Check: checkFunc(nil)})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr {
+				if checks := h.registered(); len(checks) != 0 {
+					t.Errorf("expected the check not to be registered, got %d checks", len(checks))
+				}
+				return
+			}
+
+			if checks := h.registered(); len(checks) != 1 || checks[0].Timeout != tt.want {
+				t.Errorf("expected a timeout of %s, got %+v", tt.want, checks)
+			}
+		})
+	}
+}
+
+func TestFastCheckNeverTimesOut(t *testing.T) {
+	for name, s := range strategies {
+		t.Run(name, func(t *testing.T) {
+			h := newHealth(t, WithExecutionStrategy(s), WithChecks(Check{Name: "db", Timeout: minTimeout, Check: checkFunc(nil)}))
+
+			for i := 0; i < 1000; i++ {
+				if got := h.Check(context.Background()).Checks["db"].Status; got != StatusOK {
+					t.Fatalf("expected a fast check to be %q, got %q on run %d", StatusOK, got, i)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestWorstCheck(t *testing.T) {
+	tests := map[string]struct {
+		durations map[string]time.Duration
+		critical  map[string]bool
+		want      string
+	}{
+		"no failure": {want: ""},
+		"critical over non critical": {
+			durations: map[string]time.Duration{"db": time.Millisecond, "cache": time.Second},
+			critical:  map[string]bool{"db": true, "cache": false},
+			want:      "db",
+		},
+		"slowest among critical": {
+			durations: map[string]time.Duration{"db": time.Millisecond, "queue": time.Second},
+			critical:  map[string]bool{"db": true, "queue": true},
+			want:      "queue",
+		},
+		"name breaks ties": {
+			durations: map[string]time.Duration{"db": time.Second, "cache": time.Second},
+			critical:  map[string]bool{"db": false, "cache": false},
+			want:      "cache",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			results := make(map[string]CheckResult, len(tt.durations))
+			for n, d := range tt.durations {
+				results[n] = CheckResult{Duration: d}
+			}
+
+			if got := worstCheck(results, tt.critical); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestResultWorstCheck(t *testing.T) {
+	h := newHealth(t, WithChecks(
+		Check{Name: "db", Check: checkFunc(errors.New("connection refused"))},
+		Check{Name: "cache", SkipOnErr: true, Check: func(context.Context) error {
+			time.Sleep(5 * time.Millisecond)
+			return errors.New("timeout")
+		}},
+		Check{Name: "search", Check: checkFunc(fmt.Errorf("%w: slow queries", ErrDegraded))},
+		Check{Name: "queue", Check: checkFunc(nil)},
+	))
+
+	if r := h.Check(context.Background()); r.WorstCheck != "db" {
+		t.Errorf("expected the only critical failure to be the worst check, got %q", r.WorstCheck)
+	}
+}